@@ -1,15 +1,19 @@
 package main
 
 import (
+	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	shared "tracking-service/internal"
 	component "tracking-service/internal/components"
+	grpcservice "tracking-service/internal/grpcservices"
 	handler "tracking-service/internal/handlers"
 	repository "tracking-service/internal/repositories"
 	route "tracking-service/internal/routes"
 	service "tracking-service/internal/services"
+	worker "tracking-service/internal/workers"
 
 	"github.com/go-playground/validator/v10"
 	log "github.com/sirupsen/logrus"
@@ -18,6 +22,7 @@ import (
 	metricssdk "go.opentelemetry.io/otel/sdk/metric"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
 
@@ -32,10 +37,12 @@ var (
 // @securityDefinitions.apikey Bearer
 // @in            header
 // @name          Authorization
+// @description   後台使用者登入取得的 token，格式為 "Bearer {token}"
 
-// @securityDefinitions.apikey Bearer
+// @securityDefinitions.apikey AdminApiKey
 // @in            header
-// @name          Authorization
+// @name          x-api-key
+// @description   ADMIN_API_KEY，僅供建立後台使用者
 
 // @schemes      http https
 // @termsOfService http://your-terms-of-service.url
@@ -153,14 +160,249 @@ func main() {
 				EnvVars:     []string{"KAFKA_VERSION"},
 				Destination: &config.KafkaVersion,
 			},
+			&cli.StringFlag{
+				Name:        "kafka-partition-key",
+				Usage:       "Kafka message key used for partitioning: session_id, application_id, user_id, random",
+				Value:       shared.KafkaPartitionBySession,
+				EnvVars:     []string{"KAFKA_PARTITION_KEY"},
+				Destination: &config.KafkaPartitionKey,
+			},
 			&cli.StringFlag{
 				Name:        "admin-api-key",
-				Usage:       "Admin API key",
+				Usage:       "Bootstrap admin API key, only allowed to manage admin users",
 				EnvVars:     []string{"ADMIN_API_KEY"},
 				Destination: &config.AdminApiKey,
 			},
+			&cli.StringFlag{
+				Name:        "admin-jwt-secret",
+				Usage:       "HMAC secret used to sign admin login tokens, at least 32 bytes (empty or shorter disables admin login)",
+				EnvVars:     []string{"ADMIN_JWT_SECRET"},
+				Destination: &config.AdminJWTSecret,
+			},
+			&cli.DurationFlag{
+				Name:        "admin-jwt-ttl",
+				Usage:       "Lifetime of admin login tokens",
+				Value:       time.Hour,
+				EnvVars:     []string{"ADMIN_JWT_TTL"},
+				Destination: &config.AdminJWTTTL,
+			},
+			&cli.StringFlag{
+				Name:        "trusted-proxies",
+				Usage:       "Comma separated proxy IPs or CIDRs whose X-Forwarded-For is trusted (empty trusts none)",
+				EnvVars:     []string{"TRUSTED_PROXIES"},
+				Destination: &config.TrustedProxies,
+			},
+			&cli.StringFlag{
+				Name:        "unknown-property-policy",
+				Usage:       "How to handle event log properties without a field definition: allow, strip or reject",
+				Value:       shared.UnknownPropertyAllow,
+				EnvVars:     []string{"UNKNOWN_PROPERTY_POLICY"},
+				Destination: &config.UnknownPropertyPolicy,
+			},
+			&cli.IntFlag{
+				Name:        "event-log-batch-max-size",
+				Usage:       "Max number of event logs accepted by a single batch request",
+				Value:       100,
+				EnvVars:     []string{"EVENT_LOG_BATCH_MAX_SIZE"},
+				Destination: &config.EventLogBatchMaxSize,
+			},
+			&cli.IntFlag{
+				Name:        "event-log-stream-max-size",
+				Usage:       "Max number of event logs accepted by a single gRPC TrackEvents stream",
+				Value:       10000,
+				EnvVars:     []string{"EVENT_LOG_STREAM_MAX_SIZE"},
+				Destination: &config.EventLogStreamMaxSize,
+			},
+			&cli.Int64Flag{
+				Name:        "max-request-body-size",
+				Usage:       "Max request body size in bytes read by the batch rate limit and admin login middlewares",
+				Value:       1 << 20,
+				EnvVars:     []string{"MAX_REQUEST_BODY_SIZE"},
+				Destination: &config.MaxRequestBodySize,
+			},
+			&cli.DurationFlag{
+				Name:        "api-key-default-ttl",
+				Usage:       "Lifetime of application API keys created without an explicit expiry (0 never expires)",
+				Value:       0,
+				EnvVars:     []string{"API_KEY_DEFAULT_TTL"},
+				Destination: &config.ApiKeyDefaultTTL,
+			},
+			&cli.DurationFlag{
+				Name:        "api-key-rotation-grace-period",
+				Usage:       "How long a rotated application API key stays valid",
+				Value:       24 * time.Hour,
+				EnvVars:     []string{"API_KEY_ROTATION_GRACE_PERIOD"},
+				Destination: &config.ApiKeyRotationGracePeriod,
+			},
+			&cli.IntFlag{
+				Name:        "api-key-cache-size",
+				Usage:       "Max application API keys cached in memory (0 disables the cache)",
+				Value:       10000,
+				EnvVars:     []string{"API_KEY_CACHE_SIZE"},
+				Destination: &config.ApiKeyCacheSize,
+			},
+			&cli.DurationFlag{
+				Name:        "api-key-cache-ttl",
+				Usage:       "How long a validated application API key is cached",
+				Value:       time.Minute,
+				EnvVars:     []string{"API_KEY_CACHE_TTL"},
+				Destination: &config.ApiKeyCacheTTL,
+			},
+			&cli.DurationFlag{
+				Name:        "api-key-negative-cache-ttl",
+				Usage:       "How long an unknown application API key is cached",
+				Value:       10 * time.Second,
+				EnvVars:     []string{"API_KEY_NEGATIVE_CACHE_TTL"},
+				Destination: &config.ApiKeyNegativeCacheTTL,
+			},
+			&cli.StringFlag{
+				Name:        "idempotency-store",
+				Usage:       "Idempotency key store: memory, postgres",
+				Value:       shared.IdempotencyStoreMemory,
+				EnvVars:     []string{"IDEMPOTENCY_STORE"},
+				Destination: &config.IdempotencyStore,
+			},
+			&cli.DurationFlag{
+				Name:        "idempotency-window",
+				Usage:       "Window in which duplicate message ids are ignored",
+				Value:       24 * time.Hour,
+				EnvVars:     []string{"IDEMPOTENCY_WINDOW"},
+				Destination: &config.IdempotencyWindow,
+			},
+			&cli.IntFlag{
+				Name:        "idempotency-cache-size",
+				Usage:       "Max message ids kept by the in-memory idempotency store",
+				Value:       100000,
+				EnvVars:     []string{"IDEMPOTENCY_CACHE_SIZE"},
+				Destination: &config.IdempotencyCacheSize,
+			},
+			&cli.DurationFlag{
+				Name:        "idempotency-cleanup-interval",
+				Usage:       "Interval between deletions of expired idempotency keys in Postgres, 0 to disable",
+				Value:       10 * time.Minute,
+				EnvVars:     []string{"IDEMPOTENCY_CLEANUP_INTERVAL"},
+				Destination: &config.IdempotencyCleanupInterval,
+			},
+			&cli.DurationFlag{
+				Name:        "usage-flush-interval",
+				Usage:       "How often usage counted in memory is written to Postgres",
+				Value:       30 * time.Second,
+				EnvVars:     []string{"USAGE_FLUSH_INTERVAL"},
+				Destination: &config.UsageFlushInterval,
+			},
+			&cli.Float64Flag{
+				Name:        "quota-soft-limit-ratio",
+				Usage:       "Share of a monthly quota after which responses carry a warning header (0 disables the warning)",
+				Value:       0.8,
+				EnvVars:     []string{"QUOTA_SOFT_LIMIT_RATIO"},
+				Destination: &config.QuotaSoftLimitRatio,
+			},
+			&cli.IntFlag{
+				Name:        "quota-exceeded-status",
+				Usage:       "HTTP status returned once a monthly quota is exceeded: 402, 429",
+				Value:       http.StatusPaymentRequired,
+				EnvVars:     []string{"QUOTA_EXCEEDED_STATUS"},
+				Destination: &config.QuotaExceededStatus,
+			},
+			&cli.DurationFlag{
+				Name:        "outbox-poll-interval",
+				Usage:       "Interval between outbox relay runs",
+				Value:       time.Second,
+				EnvVars:     []string{"OUTBOX_POLL_INTERVAL"},
+				Destination: &config.OutboxPollInterval,
+			},
+			&cli.IntFlag{
+				Name:        "outbox-batch-size",
+				Usage:       "Max number of outbox messages relayed per run",
+				Value:       100,
+				EnvVars:     []string{"OUTBOX_BATCH_SIZE"},
+				Destination: &config.OutboxBatchSize,
+			},
+			&cli.IntFlag{
+				Name:        "outbox-max-attempts",
+				Usage:       "Number of relay attempts before an outbox message is marked failed (0 retries forever)",
+				Value:       10,
+				EnvVars:     []string{"OUTBOX_MAX_ATTEMPTS"},
+				Destination: &config.OutboxMaxAttempts,
+			},
+			&cli.DurationFlag{
+				Name:        "outbox-retention",
+				Usage:       "Time delivered outbox messages are kept before deletion, 0 keeps them forever",
+				Value:       7 * 24 * time.Hour,
+				EnvVars:     []string{"OUTBOX_RETENTION"},
+				Destination: &config.OutboxRetention,
+			},
+			&cli.DurationFlag{
+				Name:        "outbox-cleanup-interval",
+				Usage:       "Interval between deletions of delivered outbox messages past the retention, 0 to disable",
+				Value:       time.Hour,
+				EnvVars:     []string{"OUTBOX_CLEANUP_INTERVAL"},
+				Destination: &config.OutboxCleanupInterval,
+			},
+			&cli.DurationFlag{
+				Name:        "session-inactivity-timeout",
+				Usage:       "Idle time after which open sessions are ended, for applications without their own timeout (0 disables, at least 1m)",
+				Value:       30 * time.Minute,
+				EnvVars:     []string{"SESSION_INACTIVITY_TIMEOUT"},
+				Destination: &config.SessionInactivityTimeout,
+			},
+			&cli.DurationFlag{
+				Name:        "session-sweep-interval",
+				Usage:       "Interval between idle session sweeps",
+				Value:       time.Minute,
+				EnvVars:     []string{"SESSION_SWEEP_INTERVAL"},
+				Destination: &config.SessionSweepInterval,
+			},
+			&cli.StringFlag{
+				Name:        "clickhouse-endpoint",
+				Usage:       "ClickHouse HTTP endpoint",
+				Value:       "http://localhost:8123",
+				EnvVars:     []string{"CLICKHOUSE_ENDPOINT"},
+				Destination: &config.ClickhouseEndpoint,
+			},
+			&cli.StringFlag{
+				Name:        "clickhouse-db",
+				Usage:       "ClickHouse database name",
+				EnvVars:     []string{"CLICKHOUSE_DB"},
+				Destination: &config.ClickhouseDb,
+			},
+			&cli.StringFlag{
+				Name:        "clickhouse-user",
+				Usage:       "ClickHouse user",
+				EnvVars:     []string{"CLICKHOUSE_USER"},
+				Destination: &config.ClickhouseUser,
+			},
+			&cli.StringFlag{
+				Name:        "clickhouse-password",
+				Usage:       "ClickHouse password",
+				EnvVars:     []string{"CLICKHOUSE_PASSWORD"},
+				Destination: &config.ClickhousePassword,
+			},
 		},
 		Action: execute,
+		Commands: []*cli.Command{
+			{
+				Name:  "worker",
+				Usage: "consume tracking events from Kafka and load them into ClickHouse",
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:        "worker-batch-size",
+						Usage:       "Max number of event logs per ClickHouse insert",
+						Value:       1000,
+						EnvVars:     []string{"WORKER_BATCH_SIZE"},
+						Destination: &config.WorkerBatchSize,
+					},
+					&cli.DurationFlag{
+						Name:        "worker-flush-interval",
+						Usage:       "Max time to buffer event logs before flushing to ClickHouse",
+						Value:       5 * time.Second,
+						EnvVars:     []string{"WORKER_FLUSH_INTERVAL"},
+						Destination: &config.WorkerFlushInterval,
+					},
+				},
+				Action: executeWorker,
+			},
+		},
 	}
 	err := app.Run(os.Args)
 	if err != nil {
@@ -168,7 +410,7 @@ func main() {
 	}
 }
 
-func execute(cCtx *cli.Context) error {
+func setupLogger() {
 	log.AddHook(otellogrus.NewHook(otellogrus.WithLevels(
 		log.PanicLevel,
 		log.FatalLevel,
@@ -183,10 +425,42 @@ func execute(cCtx *cli.Context) error {
 	default:
 		log.SetFormatter(&log.TextFormatter{})
 	}
+}
 
+// validateConfig 於啟動時檢查列舉型設定，避免拼錯時靜默退回預設行為
+func validateConfig(c *shared.Config) error {
+	switch c.IdempotencyStore {
+	case shared.IdempotencyStoreMemory, shared.IdempotencyStorePostgres:
+	default:
+		return fmt.Errorf("invalid IDEMPOTENCY_STORE %q: must be %s or %s", c.IdempotencyStore, shared.IdempotencyStoreMemory, shared.IdempotencyStorePostgres)
+	}
+	switch c.UnknownPropertyPolicy {
+	case shared.UnknownPropertyAllow, shared.UnknownPropertyStrip, shared.UnknownPropertyReject:
+	default:
+		return fmt.Errorf("invalid UNKNOWN_PROPERTY_POLICY %q: must be %s, %s or %s", c.UnknownPropertyPolicy, shared.UnknownPropertyAllow, shared.UnknownPropertyStrip, shared.UnknownPropertyReject)
+	}
+	switch c.KafkaPartitionKey {
+	case shared.KafkaPartitionBySession, shared.KafkaPartitionByApplication, shared.KafkaPartitionByUser, shared.KafkaPartitionRandom:
+	default:
+		return fmt.Errorf("invalid KAFKA_PARTITION_KEY %q: must be %s, %s, %s or %s", c.KafkaPartitionKey, shared.KafkaPartitionBySession, shared.KafkaPartitionByApplication, shared.KafkaPartitionByUser, shared.KafkaPartitionRandom)
+	}
+	return nil
+}
+
+func execute(cCtx *cli.Context) error {
+	if err := validateConfig(&config); err != nil {
+		return err
+	}
+	setupLogger()
 	log.Infof("Starting %s", config.OtlpServiceName)
 
-	fx.New(
+	fx.New(serverOptions()).Run()
+	return nil
+}
+
+// serverOptions 為 API 服務的依賴圖
+func serverOptions() fx.Option {
+	return fx.Options(
 		fx.Supply(&config),
 		fx.Provide(
 			component.NewOtlpConn,
@@ -194,6 +468,7 @@ func execute(cCtx *cli.Context) error {
 			component.NewMeterProvider,
 			component.NewSnowflake,
 			component.NewDb,
+			component.NewClickhouse,
 			component.NewValidator,
 			component.NewProducer,
 			component.NewHttpServer,
@@ -203,28 +478,92 @@ func execute(cCtx *cli.Context) error {
 			),
 			AsRouteRegistrar(route.NewAdminRoutes),
 			AsRouteRegistrar(route.NewTenantRoutes),
+			fx.Annotate(
+				component.NewGrpcServer,
+				fx.ParamTags("", "", `group:"grpcServices"`),
+			),
+			AsGrpcService(grpcservice.NewIngestService),
+			AsGrpcService(grpcservice.NewTrackingAdminService),
 			handler.NewAdminHandler,
 			handler.NewTenantHandler,
+			handler.NewAdminUserHandler,
 			service.NewTenantService,
 			service.NewPlatformService,
 			service.NewApplicationService,
 			service.NewEventService,
+			service.NewOutboxService,
+			service.NewRateLimitService,
+			service.NewUsageService,
+			service.NewAdminUserService,
+			service.NewAuditService,
+			service.NewAnalyticsService,
+			service.NewSessionCache,
 			repository.NewTenantRepository,
 			repository.NewPlatformRepository,
 			repository.NewApplicationRepository,
 			repository.NewEventRepository,
+			repository.NewOutboxRepository,
+			repository.NewIdempotencyRepository,
+			repository.NewUsageRepository,
+			repository.NewAdminUserRepository,
+			repository.NewAuditLogRepository,
+			repository.NewAnalyticsRepository,
+			worker.NewOutboxRelay,
+			worker.NewAPIKeyCacheInvalidator,
+			worker.NewUsageFlusher,
+			worker.NewSessionSweeper,
+			worker.NewIdempotencyKeyCleaner,
+			worker.NewOutboxCleaner,
 		),
 		fx.Invoke(
 			func(*tracesdk.TracerProvider) {},
 			func(*metricssdk.MeterProvider) {},
 			func(*gorm.DB) {},
 			func(*http.Server) {},
+			func(*grpc.Server) {},
 			func(*validator.Validate) {},
+			func(*worker.OutboxRelay) {},
+			func(*worker.APIKeyCacheInvalidator) {},
+			func(*worker.UsageFlusher) {},
+			func(*worker.SessionSweeper) {},
+			func(*worker.IdempotencyKeyCleaner) {},
+			func(*worker.OutboxCleaner) {},
 		),
-	).Run()
+	)
+}
+
+func executeWorker(cCtx *cli.Context) error {
+	if err := validateConfig(&config); err != nil {
+		return err
+	}
+	setupLogger()
+	log.Infof("Starting %s worker", config.OtlpServiceName)
+
+	fx.New(workerOptions()).Run()
 	return nil
 }
 
+// workerOptions 為 ETL Worker 的依賴圖
+func workerOptions() fx.Option {
+	return fx.Options(
+		fx.Supply(&config),
+		fx.Provide(
+			component.NewOtlpConn,
+			component.NewTracerProvider,
+			component.NewMeterProvider,
+			component.NewClickhouse,
+			component.NewConsumerGroup,
+			repository.NewEventLogRepository,
+			worker.NewEventLogConsumer,
+		),
+		fx.Invoke(
+			func(*tracesdk.TracerProvider) {},
+			func(*metricssdk.MeterProvider) {},
+			func(*worker.EventLogConsumer) {},
+		),
+	)
+}
+
 func AsRouteRegistrar(f any) any {
 	return fx.Annotate(
 		f,
@@ -232,3 +571,11 @@ func AsRouteRegistrar(f any) any {
 		fx.ResultTags(`group:"routeRegistrars"`),
 	)
 }
+
+func AsGrpcService(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(component.GrpcService)),
+		fx.ResultTags(`group:"grpcServices"`),
+	)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+	shared "tracking-service/internal"
+
+	"go.uber.org/fx"
+)
+
+func TestDependencyGraphs(t *testing.T) {
+	tests := []struct {
+		name    string
+		options fx.Option
+	}{
+		{name: "server", options: serverOptions()},
+		{name: "worker", options: workerOptions()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := fx.ValidateApp(tt.options); err != nil {
+				t.Fatalf("ValidateApp() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	valid := func() *shared.Config {
+		return &shared.Config{
+			IdempotencyStore:      shared.IdempotencyStoreMemory,
+			UnknownPropertyPolicy: shared.UnknownPropertyAllow,
+			KafkaPartitionKey:     shared.KafkaPartitionBySession,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(c *shared.Config)
+		wantErr bool
+	}{
+		{name: "defaults", modify: func(c *shared.Config) {}},
+		{name: "postgres idempotency store", modify: func(c *shared.Config) { c.IdempotencyStore = shared.IdempotencyStorePostgres }},
+		{name: "unknown idempotency store", modify: func(c *shared.Config) { c.IdempotencyStore = "redis" }, wantErr: true},
+		{name: "reject unknown properties", modify: func(c *shared.Config) { c.UnknownPropertyPolicy = shared.UnknownPropertyReject }},
+		{name: "unknown property policy", modify: func(c *shared.Config) { c.UnknownPropertyPolicy = "drop" }, wantErr: true},
+		{name: "random partition key", modify: func(c *shared.Config) { c.KafkaPartitionKey = shared.KafkaPartitionRandom }},
+		{name: "unknown partition key", modify: func(c *shared.Config) { c.KafkaPartitionKey = "tenant_id" }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.modify(c)
+			if err := validateConfig(c); (err != nil) != tt.wantErr {
+				t.Fatalf("validateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
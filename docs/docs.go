@@ -22,17 +22,72 @@ const docTemplate = `{
     "paths": {
         "/admin/apps": {
             "get": {
-                "description": "取得所有應用程式",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "分頁取得應用程式，限定租戶的使用者僅回傳所屬租戶的應用程式",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Admin/Application"
                 ],
-                "summary": "取得所有應用程式",
+                "summary": "取得應用程式列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租戶 ID",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含所有應用程式陣列",
+                        "description": "成功回應，包含應用程式陣列，有下一頁時帶入 next_cursor",
                         "schema": {
                             "allOf": [
                                 {
@@ -91,6 +146,11 @@ const docTemplate = `{
                 }
             },
             "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "description": "建立新應用程式",
                 "consumes": [
                     "application/json"
@@ -173,6 +233,11 @@ const docTemplate = `{
         },
         "/admin/apps/{app_id}": {
             "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "description": "取得指定應用程式詳細資料",
                 "produces": [
                     "application/json"
@@ -248,6 +313,11 @@ const docTemplate = `{
                 }
             },
             "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "description": "更新指定應用程式",
                 "produces": [
                     "application/json"
@@ -317,6 +387,11 @@ const docTemplate = `{
                 }
             },
             "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "description": "刪除指定應用程式",
                 "produces": [
                     "application/json"
@@ -377,16 +452,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/apps/{app_id}/api-keys/{api_key_id}": {
-            "delete": {
-                "description": "刪除應用程式 API 密鑰",
+        "/admin/apps/{app_id}/analytics/events": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "依時間區間彙總應用程式的事件數、不重複 session 數與不重複使用者數，可依平台或屬性分組",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Application"
+                    "Admin/Analytics"
                 ],
-                "summary": "刪除應用程式 API 密鑰",
+                "summary": "查詢事件分析",
                 "parameters": [
                     {
                         "type": "string",
@@ -396,16 +476,83 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "應用程式 API 密鑰 ID",
-                        "name": "api_key_id",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "起始時間 (Unix 秒數)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結束時間 (Unix 秒數，不含)",
+                        "name": "to",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "minute",
+                            "hour",
+                            "day"
+                        ],
+                        "type": "string",
+                        "description": "時間區間，預設 hour",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "平台 ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "platform",
+                            "property"
+                        ],
+                        "type": "string",
+                        "description": "分組方式",
+                        "name": "breakdown_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分組的屬性名稱，breakdown_by 為 property 時必填",
+                        "name": "breakdown_property",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "屬性篩選，以 properties[key]=value 帶入，例如 properties[plan]=pro",
+                        "name": "properties",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含彙總與各時間區間的數量",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventAnalyticsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -446,16 +593,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/apps/{app_id}/api_keys": {
+        "/admin/apps/{app_id}/analytics/funnels": {
             "post": {
-                "description": "建立應用程式 API 密鑰",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "依序計算在時間窗內完成各步驟事件的 session 或使用者數，步驟間隔以依序第一次完成各步驟的時間計算",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Application"
+                    "Admin/Analytics"
                 ],
-                "summary": "建立應用程式 API 密鑰",
+                "summary": "漏斗分析",
                 "parameters": [
                     {
                         "type": "string",
@@ -463,11 +618,20 @@ const docTemplate = `{
                         "name": "app_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "漏斗步驟與條件",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateFunnelRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新應用程式 API 密鑰",
+                        "description": "成功回應，包含各步驟人數、轉換率與間隔時間中位數",
                         "schema": {
                             "allOf": [
                                 {
@@ -477,7 +641,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.ApplicationAPIKey"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.FunnelResponse"
                                         }
                                     }
                                 }
@@ -523,35 +687,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/apps/{app_id}/events/{event_id}/fields/{field_id}": {
-            "get": {
-                "description": "取得指定事件欄位",
+        "/admin/apps/{app_id}/analytics/paths": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "以每個 session 第一次觸發錨點事件的位置為起點，依 direction 取之前或之後 depth 個事件，合併數量最多的 top_n 條路徑為 Sankey 圖的節點與連線",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Analytics"
                 ],
-                "summary": "取得指定事件欄位",
+                "summary": "路徑分析",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "欄位 ID",
-                        "name": "field_id",
-                        "in": "path",
-                        "required": true
+                        "description": "錨點事件、方向與深度",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreatePathRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含應用程式詳細資料",
+                        "description": "成功回應，包含路徑的節點與連線",
                         "schema": {
                             "allOf": [
                                 {
@@ -561,7 +735,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.PathResponse"
                                         }
                                     }
                                 }
@@ -605,44 +779,62 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "更新指定事件欄位",
+            }
+        },
+        "/admin/apps/{app_id}/analytics/retention": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "以第一次觸發 cohort 事件的日或週分組，計算之後各週期觸發回訪事件的使用者數，可選擇將匿名 session 排除或以 session_key 計算",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Analytics"
                 ],
-                "summary": "更新指定事件欄位",
+                "summary": "留存分析",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "欄位 ID",
-                        "name": "field_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新事件欄位資料",
+                        "description": "cohort 與回訪事件及週期",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateRetentionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含各 cohort 的留存人數與比例",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.RetentionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -683,19 +875,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/events": {
+        "/admin/apps/{app_id}/analytics/sessions": {
             "get": {
-                "description": "取得所有事件",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "計算開始時間在範圍內的 session 數、平均與百分位時長、跳出率與每個 session 的事件數，尚未結束的 session 以最後一筆事件時間推算時長",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Analytics"
+                ],
+                "summary": "查詢 session 分析",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "session 開始時間起點 (Unix 秒數)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "session 開始時間終點 (Unix 秒數，不含)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "平台 ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "platform",
+                            "day",
+                            "user_agent"
+                        ],
+                        "type": "string",
+                        "description": "分組方式",
+                        "name": "breakdown_by",
+                        "in": "query"
+                    }
                 ],
-                "summary": "取得所有事件",
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含所有事件陣列",
+                        "description": "成功回應，包含彙總與各分組的 session 指標",
                         "schema": {
                             "allOf": [
                                 {
@@ -705,10 +942,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
-                                            }
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.SessionAnalyticsResponse"
                                         }
                                     }
                                 }
@@ -752,9 +986,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/apps/{app_id}/api-keys": {
             "post": {
-                "description": "建立新事件",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "建立應用程式 API 密鑰，可指定權限範圍與到期時間",
                 "consumes": [
                     "application/json"
                 ],
@@ -762,23 +1003,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Application"
                 ],
-                "summary": "建立新事件",
+                "summary": "建立應用程式 API 密鑰",
                 "parameters": [
                     {
-                        "description": "新增事件資料",
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "權限範圍與到期時間，未指定時授予全部權限並套用預設有效期",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateApplicationAPIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新事件資料",
+                        "description": "成功回應，包含新應用程式 API 密鑰，完整密鑰僅回傳一次",
                         "schema": {
                             "allOf": [
                                 {
@@ -788,7 +1035,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.ApplicationAPIKey"
                                         }
                                     }
                                 }
@@ -834,43 +1081,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/events/{event_id}": {
-            "get": {
-                "description": "取得指定事件詳細資料",
+        "/admin/apps/{app_id}/api-keys/{api_key_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "刪除應用程式 API 密鑰",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Application"
                 ],
-                "summary": "取得指定事件詳細資料",
+                "summary": "刪除應用程式 API 密鑰",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "應用程式 API 密鑰 ID",
+                        "name": "api_key_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "成功回應，包含應用程式詳細資料",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                    "204": {
+                        "description": "成功，無內容回應"
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -909,32 +1153,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "更新指定事件",
+            }
+        },
+        "/admin/apps/{app_id}/api-keys/{api_key_id}/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "立即停用應用程式 API 密鑰，保留紀錄",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Application"
                 ],
-                "summary": "更新指定事件",
+                "summary": "撤銷應用程式 API 密鑰",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新事件資料",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
-                        }
+                        "type": "string",
+                        "description": "應用程式 API 密鑰 ID",
+                        "name": "api_key_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -978,28 +1227,68 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除指定事件",
+            }
+        },
+        "/admin/apps/{app_id}/api-keys/{api_key_id}/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "以相同權限範圍建立新密鑰，舊密鑰在寬限期內仍可使用",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Event"
+                    "Admin/Application"
                 ],
-                "summary": "刪除指定事件",
+                "summary": "輪替應用程式 API 密鑰",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "應用程式 API 密鑰 ID",
+                        "name": "api_key_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "新密鑰到期時間，未指定時套用預設有效期",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.RotateApplicationAPIKeyRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含新應用程式 API 密鑰，完整密鑰僅回傳一次",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.ApplicationAPIKey"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -1040,28 +1329,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/events/{event_id}/fields": {
+        "/admin/apps/{app_id}/events": {
             "get": {
-                "description": "取得指定事件欄位",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "分頁取得應用程式的事件",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Admin/Event"
                 ],
-                "summary": "取得指定事件欄位",
+                "summary": "取得事件列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "平台 ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否啟用",
+                        "name": "is_active",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含應用程式詳細資料",
+                        "description": "成功回應，包含事件陣列，有下一頁時帶入 next_cursor",
                         "schema": {
                             "allOf": [
                                 {
@@ -1073,7 +1421,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
                                             }
                                         }
                                     }
@@ -1120,7 +1468,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "建立新事件欄位",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "建立新事件",
                 "consumes": [
                     "application/json"
                 ],
@@ -1130,28 +1483,28 @@ const docTemplate = `{
                 "tags": [
                     "Admin/Event"
                 ],
-                "summary": "建立新事件欄位",
+                "summary": "建立新事件",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "新增事件欄位資料",
+                        "description": "新增事件資料",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新事件欄位資料",
+                        "description": "成功回應，包含新事件資料",
                         "schema": {
                             "allOf": [
                                 {
@@ -1161,7 +1514,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
                                         }
                                     }
                                 }
@@ -1207,35 +1560,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/events/{event_id}/fields/{field_id}": {
-            "delete": {
-                "description": "刪除指定事件欄位",
+        "/admin/apps/{app_id}/events/{event_id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得指定事件詳細資料",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Admin/Event"
                 ],
-                "summary": "刪除指定事件欄位",
+                "summary": "取得指定事件詳細資料",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "欄位 ID",
-                        "name": "field_id",
+                        "description": "事件 ID",
+                        "name": "event_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含應用程式詳細資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -1274,39 +1647,49 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/admin/platforms": {
-            "get": {
-                "description": "取得所有平台",
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "更新指定事件",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Platform"
+                    "Admin/Event"
                 ],
-                "summary": "取得所有平台",
-                "responses": {
-                    "200": {
-                        "description": "成功回應，包含所有平台陣列",
+                "summary": "更新指定事件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新事件資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
                         }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -1346,47 +1729,39 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "建立新平台",
-                "consumes": [
-                    "application/json"
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "刪除指定事件",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Platform"
+                    "Admin/Event"
                 ],
-                "summary": "建立新平台",
+                "summary": "刪除指定事件",
                 "parameters": [
                     {
-                        "description": "新增平台資料",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
-                        }
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "成功回應，包含新平台資料",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
+                    "204": {
+                        "description": "成功，無內容回應"
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -1427,96 +1802,82 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/platforms/{platform_id}": {
+        "/admin/apps/{app_id}/events/{event_id}/fields": {
             "get": {
-                "description": "取得指定平台詳細資料",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "分頁取得指定事件的欄位",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Platform"
+                    "Admin/Event"
                 ],
-                "summary": "取得指定平台詳細資料",
+                "summary": "取得事件欄位列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "平台 ID",
-                        "name": "platform_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "成功回應，包含應用程式詳細資料",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
-                    "400": {
-                        "description": "錯誤回應：無效請求",
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
-                        }
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
                     },
-                    "401": {
-                        "description": "錯誤回應：未授權",
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
-                        }
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "錯誤回應：禁止訪問",
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "錯誤回應：找不到資源",
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
-                        }
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
                     },
-                    "409": {
-                        "description": "錯誤回應：重複鍵",
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
-                        }
+                    {
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "錯誤回應：伺服器錯誤",
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
                     }
-                }
-            }
-        },
-        "/admin/tenants": {
-            "get": {
-                "description": "取得所有租戶",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Admin/Tenant"
                 ],
-                "summary": "取得所有租戶",
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含所有租戶陣列",
+                        "description": "成功回應，包含事件欄位陣列，有下一頁時帶入 next_cursor",
                         "schema": {
                             "allOf": [
                                 {
@@ -1528,7 +1889,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
                                             }
                                         }
                                     }
@@ -1575,7 +1936,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "建立新租戶",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "建立新事件欄位",
                 "consumes": [
                     "application/json"
                 ],
@@ -1583,23 +1949,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Tenant"
+                    "Admin/Event"
                 ],
-                "summary": "建立新租戶",
+                "summary": "建立新事件欄位",
                 "parameters": [
                     {
-                        "description": "新增租戶資料",
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "新增事件欄位資料",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新租戶資料",
+                        "description": "成功回應，包含新事件欄位資料",
                         "schema": {
                             "allOf": [
                                 {
@@ -1609,7 +1989,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
                                         }
                                     }
                                 }
@@ -1655,21 +2035,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/tenants/{tenant_id}": {
+        "/admin/apps/{app_id}/events/{event_id}/fields/{field_id}": {
             "get": {
-                "description": "取得指定租戶詳細資料",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得指定事件欄位",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Tenant"
+                    "Admin/Event"
                 ],
-                "summary": "取得指定租戶詳細資料",
+                "summary": "取得指定事件欄位",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租戶 ID",
-                        "name": "tenant_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位 ID",
+                        "name": "field_id",
                         "in": "path",
                         "required": true
                     }
@@ -1686,7 +2085,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
                                         }
                                     }
                                 }
@@ -1732,29 +2131,48 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "更新指定租戶",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "更新指定事件欄位",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Admin/Tenant"
+                    "Admin/Event"
                 ],
-                "summary": "更新指定租戶",
+                "summary": "更新指定事件欄位",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租戶 ID",
-                        "name": "tenant_id",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新租戶資料",
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位 ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新事件欄位資料",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
                         }
                     }
                 ],
@@ -1799,42 +2217,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/platforms": {
-            "get": {
-                "description": "取得所有平台的名稱列表",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "刪除指定事件欄位",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Platform"
+                    "Admin/Event"
                 ],
-                "summary": "取得平台列表",
-                "responses": {
-                    "200": {
-                        "description": "成功回應，包含平台陣列",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "400": {
-                        "description": "錯誤回應：無效請求",
+                "summary": "刪除指定事件欄位",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "app_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位 ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
                         "schema": {
                             "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
                         }
@@ -1872,19 +2298,113 @@ const docTemplate = `{
                 }
             }
         },
-        "/tenant/events": {
+        "/admin/audit-logs": {
             "get": {
-                "description": "取得指定應用程式的事件列表",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "依建立時間由新到舊查詢設定異動紀錄，限定租戶的使用者僅能查詢所屬租戶",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Audit"
+                ],
+                "summary": "查詢稽核紀錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "租戶 ID",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "application_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "admin_user",
+                            "bootstrap",
+                            "api_key",
+                            "system"
+                        ],
+                        "type": "string",
+                        "description": "操作者類型",
+                        "name": "actor_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作者 ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "rotate",
+                            "revoke"
+                        ],
+                        "type": "string",
+                        "description": "操作",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "tenant",
+                            "platform",
+                            "application",
+                            "api_key",
+                            "event",
+                            "event_field"
+                        ],
+                        "type": "string",
+                        "description": "資源類型",
+                        "name": "resource_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "資源 ID",
+                        "name": "resource_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "起始時間 (Unix 秒數)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結束時間 (Unix 秒數)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "取得事件列表",
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含事件陣列",
+                        "description": "成功回應，包含稽核紀錄陣列，有下一頁時帶入 next_cursor",
                         "schema": {
                             "allOf": [
                                 {
@@ -1896,7 +2416,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.AuditLog"
                                             }
                                         }
                                     }
@@ -1941,9 +2461,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/login": {
             "post": {
-                "description": "建立新事件",
+                "description": "以帳號密碼登入並取得 token，後續請求以 Authorization: Bearer {token} 帶入；ADMIN_JWT_SECRET 未設定或短於 32 bytes 時停用；同一來源 IP 或帳號嘗試過於頻繁時回傳 429",
                 "consumes": [
                     "application/json"
                 ],
@@ -1951,23 +2473,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/User"
                 ],
-                "summary": "建立事件",
+                "summary": "後台使用者登入",
                 "parameters": [
                     {
-                        "description": "新增事件資料",
+                        "description": "登入資料",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventRequest"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.AdminLoginRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新事件資料",
+                        "description": "成功回應，包含 token 與使用者資料",
                         "schema": {
                             "allOf": [
                                 {
@@ -1977,7 +2499,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.AdminLoginResponse"
                                         }
                                     }
                                 }
@@ -2023,28 +2545,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/tenant/events/{event_id}": {
+        "/admin/me": {
             "get": {
-                "description": "取得指定事件的詳細資料",
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "AdminApiKey": []
+                    }
+                ],
+                "description": "取得目前請求的後台身分，以 ADMIN_API_KEY 驗證時角色為 bootstrap",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
-                ],
-                "summary": "取得事件詳細資料",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin/User"
                 ],
+                "summary": "取得目前身分",
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含事件詳細資料",
+                        "description": "成功回應，包含目前身分",
                         "schema": {
                             "allOf": [
                                 {
@@ -2054,7 +2575,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.AdminPrincipal"
                                         }
                                     }
                                 }
@@ -2098,37 +2619,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "更新指定事件",
+            }
+        },
+        "/admin/outbox": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得 outbox 中各狀態的訊息數量與最早待送時間",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Outbox"
                 ],
-                "summary": "更新事件",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "更新事件資料",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                "summary": "取得 outbox 積壓狀態",
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含 outbox 積壓狀態",
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateEventRequest"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.OutboxStats"
+                                        }
+                                    }
+                                }
+                            ]
                         }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -2167,28 +2692,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "刪除指定事件",
+            }
+        },
+        "/admin/outbox/redrive": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "將失敗與等待中的 outbox 訊息重設為立即重送，最近 30 秒內領取或更新的訊息可能正在傳送而不重設",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
-                ],
-                "summary": "刪除事件",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin/Outbox"
                 ],
+                "summary": "重送 outbox 訊息",
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含重送訊息數量",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.OutboxRedriveResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -2229,37 +2767,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/tenant/events/{event_id}/event_logs": {
-            "post": {
-                "description": "建立新事件日誌",
+        "/admin/platforms": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "分頁取得平台",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Platform"
                 ],
-                "summary": "建立事件日誌",
+                "summary": "取得平台列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
-                        "description": "新增事件日誌資料",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventLogRequest"
-                        }
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新事件日誌資料",
+                        "description": "成功回應，包含平台陣列，有下一頁時帶入 next_cursor",
                         "schema": {
                             "allOf": [
                                 {
@@ -2269,7 +2838,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventLog"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
+                                            }
                                         }
                                     }
                                 }
@@ -2313,11 +2885,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tenant/events/{event_id}/fields": {
+            },
             "post": {
-                "description": "建立新事件欄位",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "建立新平台",
                 "consumes": [
                     "application/json"
                 ],
@@ -2325,30 +2900,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Platform"
                 ],
-                "summary": "建立事件欄位",
+                "summary": "建立新平台",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "新增事件欄位資料",
+                        "description": "新增平台資料",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventFieldRequest"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應：新增事件欄位資料",
+                        "description": "成功回應，包含新平台資料",
                         "schema": {
                             "allOf": [
                                 {
@@ -2358,7 +2926,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
                                         }
                                     }
                                 }
@@ -2404,35 +2972,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/tenant/events/{event_id}/fields/{field_id}": {
+        "/admin/platforms/{platform_id}": {
             "get": {
-                "description": "取得指定事件欄位",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得指定平台詳細資料",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Platform"
                 ],
-                "summary": "取得事件欄位",
+                "summary": "取得指定平台詳細資料",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "欄位 ID",
-                        "name": "field_id",
+                        "description": "平台 ID",
+                        "name": "platform_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應：應用程式詳細資料",
+                        "description": "成功回應，包含應用程式詳細資料",
                         "schema": {
                             "allOf": [
                                 {
@@ -2442,7 +3008,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
                                         }
                                     }
                                 }
@@ -2486,44 +3052,88 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "更新指定事件欄位",
+            }
+        },
+        "/admin/tenants": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "分頁取得租戶，限定租戶的使用者僅回傳所屬租戶",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Tenant"
                 ],
-                "summary": "更新事件欄位",
+                "summary": "取得租戶列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
                         "type": "string",
-                        "description": "欄位 ID",
-                        "name": "field_id",
-                        "in": "path",
-                        "required": true
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
                     },
                     {
-                        "description": "更新事件欄位資料",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateEventFieldRequest"
-                        }
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含租戶陣列，有下一頁時帶入 next_cursor",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -2563,34 +3173,52 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "刪除指定事件欄位",
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "建立新租戶",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Event"
+                    "Admin/Tenant"
                 ],
-                "summary": "刪除事件欄位",
+                "summary": "建立新租戶",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "欄位 ID",
-                        "name": "field_id",
-                        "in": "path",
-                        "required": true
+                        "description": "新增租戶資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "成功，無內容回應"
+                    "200": {
+                        "description": "成功回應，包含新租戶資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -2631,37 +3259,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/tenant/events/{event_id}/sessions": {
-            "post": {
-                "description": "建立新會話",
+        "/admin/tenants/{tenant_id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得指定租戶詳細資料",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Session"
+                    "Admin/Tenant"
                 ],
-                "summary": "建立會話",
+                "summary": "取得指定租戶詳細資料",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "事件 ID",
-                        "name": "event_id",
+                        "description": "租戶 ID",
+                        "name": "tenant_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "新增會話資料",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateSessionRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含新會話資料",
+                        "description": "成功回應，包含應用程式詳細資料",
                         "schema": {
                             "allOf": [
                                 {
@@ -2671,7 +3295,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Session"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
                                         }
                                     }
                                 }
@@ -2715,36 +3339,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tenant/profile": {
-            "get": {
-                "description": "取得指定應用程式的詳細資料",
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "更新指定租戶",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Application"
+                    "Admin/Tenant"
                 ],
-                "summary": "取得應用程式詳細資料",
-                "responses": {
-                    "200": {
-                        "description": "成功回應，包含應用程式詳細資料",
+                "summary": "更新指定租戶",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租戶 ID",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新租戶資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Application"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.Tenant"
                         }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
                     },
                     "400": {
                         "description": "錯誤回應：無效請求",
@@ -2785,28 +3415,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/tenant/sessions/{session_id}": {
+        "/admin/tenants/{tenant_id}/usage": {
             "get": {
-                "description": "取得指定會話的詳細資料",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得指定租戶各應用程式每日的事件日誌與工作階段接受、拒絕數量，以及當月配額用量；用量最多延遲一個寫入間隔",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Session"
+                    "Admin/Tenant"
                 ],
-                "summary": "取得會話詳細資料",
+                "summary": "取得指定租戶用量",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "會話 ID",
-                        "name": "session_id",
+                        "description": "租戶 ID",
+                        "name": "tenant_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "起始日期 (UTC)，預設為當月一日",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (UTC)，預設為今日",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "應用程式 ID",
+                        "name": "application_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "成功回應，包含會話詳細資料",
+                        "description": "成功回應，包含租戶用量",
                         "schema": {
                             "allOf": [
                                 {
@@ -2816,7 +3469,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Session"
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.UsageResponse"
                                         }
                                     }
                                 }
@@ -2860,31 +3513,294 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "更新指定會話",
+            }
+        },
+        "/admin/users": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "AdminApiKey": []
+                    }
+                ],
+                "description": "取得所有後台使用者",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Session"
+                    "Admin/User"
                 ],
-                "summary": "更新會話",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "會話 ID",
-                        "name": "session_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "更新會話資料",
-                        "name": "request",
-                        "in": "body",
+                "summary": "取得所有後台使用者",
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含所有後台使用者陣列",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.AdminUser"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "AdminApiKey": []
+                    }
+                ],
+                "description": "建立後台使用者，tenant_admin 必須指定租戶，super_admin 不可指定租戶",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin/User"
+                ],
+                "summary": "建立後台使用者",
+                "parameters": [
+                    {
+                        "description": "新增後台使用者資料",
+                        "name": "request",
+                        "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateSessionRequest"
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateAdminUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含新後台使用者資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.AdminUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "AdminApiKey": []
+                    }
+                ],
+                "description": "取得指定後台使用者",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin/User"
+                ],
+                "summary": "取得指定後台使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "後台使用者 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含後台使用者資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.AdminUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "AdminApiKey": []
+                    }
+                ],
+                "description": "更新角色、租戶或密碼，異動後該使用者先前取得的 token 即失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin/User"
+                ],
+                "summary": "更新指定後台使用者",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "後台使用者 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新後台使用者資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateAdminUserRequest"
                         }
                     }
                 ],
@@ -2931,19 +3847,27 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "刪除會話",
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "AdminApiKey": []
+                    }
+                ],
+                "description": "刪除指定後台使用者，該使用者先前取得的 token 即失效",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tenant/Session"
+                    "Admin/User"
                 ],
-                "summary": "刪除會話",
+                "summary": "刪除指定後台使用者",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "會話 ID",
-                        "name": "session_id",
+                        "description": "後台使用者 ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
@@ -2990,124 +3914,2968 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "tracking-service_internal_datastructures.Application": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
+        },
+        "/platforms": {
+            "get": {
+                "description": "分頁取得平台的名稱列表",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Platform"
+                ],
+                "summary": "取得平台列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含平台陣列，有下一頁時帶入 next_cursor",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.Platform"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/analytics/events": {
+            "get": {
+                "description": "依時間區間彙總目前應用程式的事件數、不重複 session 數與不重複使用者數，可依平台或屬性分組",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Analytics"
+                ],
+                "summary": "查詢事件分析",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "起始時間 (Unix 秒數)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結束時間 (Unix 秒數，不含)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "minute",
+                            "hour",
+                            "day"
+                        ],
+                        "type": "string",
+                        "description": "時間區間，預設 hour",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "平台 ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "platform",
+                            "property"
+                        ],
+                        "type": "string",
+                        "description": "分組方式",
+                        "name": "breakdown_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分組的屬性名稱，breakdown_by 為 property 時必填",
+                        "name": "breakdown_property",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "屬性篩選，以 properties[key]=value 帶入，例如 properties[plan]=pro",
+                        "name": "properties",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含彙總與各時間區間的數量",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventAnalyticsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/analytics/funnels": {
+            "post": {
+                "description": "依序計算在時間窗內完成各步驟事件的 session 或使用者數，步驟間隔以依序第一次完成各步驟的時間計算",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Analytics"
+                ],
+                "summary": "漏斗分析",
+                "parameters": [
+                    {
+                        "description": "漏斗步驟與條件",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateFunnelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含各步驟人數、轉換率與間隔時間中位數",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.FunnelResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/analytics/paths": {
+            "post": {
+                "description": "以每個 session 第一次觸發錨點事件的位置為起點，依 direction 取之前或之後 depth 個事件，合併數量最多的 top_n 條路徑為 Sankey 圖的節點與連線",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Analytics"
+                ],
+                "summary": "路徑分析",
+                "parameters": [
+                    {
+                        "description": "錨點事件、方向與深度",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreatePathRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含路徑的節點與連線",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.PathResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/analytics/retention": {
+            "post": {
+                "description": "以第一次觸發 cohort 事件的日或週分組，計算之後各週期觸發回訪事件的使用者數，可選擇將匿名 session 排除或以 session_key 計算",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Analytics"
+                ],
+                "summary": "留存分析",
+                "parameters": [
+                    {
+                        "description": "cohort 與回訪事件及週期",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateRetentionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含各 cohort 的留存人數與比例",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.RetentionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/analytics/sessions": {
+            "get": {
+                "description": "計算開始時間在範圍內的 session 數、平均與百分位時長、跳出率與每個 session 的事件數，尚未結束的 session 以最後一筆事件時間推算時長",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Analytics"
+                ],
+                "summary": "查詢 session 分析",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "session 開始時間起點 (Unix 秒數)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "session 開始時間終點 (Unix 秒數，不含)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "平台 ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "platform",
+                            "day",
+                            "user_agent"
+                        ],
+                        "type": "string",
+                        "description": "分組方式",
+                        "name": "breakdown_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含彙總與各分組的 session 指標",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.SessionAnalyticsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/audit-logs": {
+            "get": {
+                "description": "依建立時間由新到舊查詢目前應用程式的設定異動紀錄",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Application"
+                ],
+                "summary": "查詢稽核紀錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "admin_user",
+                            "bootstrap",
+                            "api_key",
+                            "system"
+                        ],
+                        "type": "string",
+                        "description": "操作者類型",
+                        "name": "actor_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "操作者 ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "rotate",
+                            "revoke"
+                        ],
+                        "type": "string",
+                        "description": "操作",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "application",
+                            "api_key",
+                            "event",
+                            "event_field"
+                        ],
+                        "type": "string",
+                        "description": "資源類型",
+                        "name": "resource_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "資源 ID",
+                        "name": "resource_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "起始時間 (Unix 秒數)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結束時間 (Unix 秒數)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含稽核紀錄陣列，有下一頁時帶入 next_cursor",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.AuditLog"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/event-logs:batch": {
+            "post": {
+                "description": "一次建立多筆事件日誌，逐筆驗證並回傳各筆處理結果，未帶 session_id 的項目以 session_key 取得或建立 session",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "批次建立事件日誌",
+                "parameters": [
+                    {
+                        "description": "批次事件日誌資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventLogBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含各筆事件日誌處理結果",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventLogBatchResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/events": {
+            "get": {
+                "description": "分頁取得指定應用程式的事件列表",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "取得事件列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "上一頁回應的 next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "筆數，預設 50，最多 200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "-created_at",
+                            "name",
+                            "-name"
+                        ],
+                        "type": "string",
+                        "description": "排序，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "名稱包含",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間起 (Unix 秒數)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "建立時間迄 (Unix 秒數)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "平台 ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否啟用",
+                        "name": "is_active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含事件陣列，有下一頁時帶入 next_cursor",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/tracking-service_internal_datastructures.EventResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "建立新事件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "建立事件",
+                "parameters": [
+                    {
+                        "description": "新增事件資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含新事件資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/events/{event_id}": {
+            "get": {
+                "description": "取得指定事件的詳細資料",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "取得事件詳細資料",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含事件詳細資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Event"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "更新指定事件",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "更新事件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新事件資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除指定事件",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "刪除事件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/events/{event_id}/event_logs": {
+            "post": {
+                "description": "建立新事件日誌，未帶 session_id 時以 session_key 取得或建立 session，並以請求的 User-Agent 與 IP 填入；事件須為啟用中且平台相符、session 須存在且尚未結束，應用程式設定 ingestion_validation 為 tag 時改為寫入並於 violations 記錄錯誤代碼",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "建立事件日誌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "冪等鍵，未提供 message_id 時使用",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "新增事件日誌資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventLogRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含新事件日誌資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventLog"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/events/{event_id}/fields": {
+            "post": {
+                "description": "建立新事件欄位",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "建立事件欄位",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "新增事件欄位資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateEventFieldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應：新增事件欄位資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/events/{event_id}/fields/{field_id}": {
+            "get": {
+                "description": "取得指定事件欄位",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "取得事件欄位",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位 ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應：應用程式詳細資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "更新指定事件欄位",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "更新事件欄位",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位 ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新事件欄位資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateEventFieldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除指定事件欄位",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Event"
+                ],
+                "summary": "刪除事件欄位",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位 ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/events/{event_id}/sessions": {
+            "post": {
+                "description": "建立新會話",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Session"
+                ],
+                "summary": "建立會話",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "事件 ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "新增會話資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.CreateSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含新會話資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Session"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/profile": {
+            "get": {
+                "description": "取得指定應用程式的詳細資料",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Application"
+                ],
+                "summary": "取得應用程式詳細資料",
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含應用程式詳細資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Application"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/sessions": {
+            "get": {
+                "description": "取得應用程式下指定 session_key 的會話",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Session"
+                ],
+                "summary": "以 session_key 取得會話",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "會話鍵",
+                        "name": "session_key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含會話詳細資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Session"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/sessions/{session_id}": {
+            "get": {
+                "description": "取得指定會話的詳細資料",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Session"
+                ],
+                "summary": "取得會話詳細資料",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "會話 ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含會話詳細資料",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.Session"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "更新指定會話",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Session"
+                ],
+                "summary": "更新會話",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "會話 ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新會話資料",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.UpdateSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "刪除會話",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Session"
+                ],
+                "summary": "刪除會話",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "會話 ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "成功，無內容回應"
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenant/usage": {
+            "get": {
+                "description": "取得目前應用程式每日的事件日誌與工作階段接受、拒絕數量，以及租戶當月配額用量；用量最多延遲一個寫入間隔",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tenant/Application"
+                ],
+                "summary": "取得用量",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "起始日期 (UTC)，預設為當月一日",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (UTC)，預設為今日",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功回應，包含用量",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tracking-service_internal_datastructures.BaseResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/tracking-service_internal_datastructures.UsageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "錯誤回應：無效請求",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "401": {
+                        "description": "錯誤回應：未授權",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "403": {
+                        "description": "錯誤回應：禁止訪問",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "404": {
+                        "description": "錯誤回應：找不到資源",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "409": {
+                        "description": "錯誤回應：重複鍵",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    },
+                    "500": {
+                        "description": "錯誤回應：伺服器錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/tracking-service_internal_datastructures.ErrorResponseWithCode"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "tracking-service_internal_datastructures.AdminLoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct-horse-battery"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.AdminLoginResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.AdminUser"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.AdminPrincipal": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.AdminUser": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_login_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.Application": {
+            "type": "object",
+            "properties": {
+                "allowed_origins": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.ApplicationAPIKey"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ingestion_validation": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rate_limit": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.RateLimit"
+                },
+                "session_timeout_seconds": {
+                    "type": "integer"
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.ApplicationAPIKey": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "type": "string"
+                },
+                "application_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key_prefix": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_type": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "application_id": {
+                    "type": "string"
+                },
+                "before": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "client_ip": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "resource_type": {
+                    "type": "string"
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "trace_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.BaseResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "msg": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "default": true
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateAdminUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "admin@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 12,
+                    "example": "correct-horse-battery"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "super_admin",
+                        "tenant_admin",
+                        "read_only"
+                    ],
+                    "example": "tenant_admin"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateApplicationAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04:05"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ingest"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "secret",
+                        "publishable"
+                    ],
+                    "example": "publishable"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateEventFieldRequest": {
+            "type": "object",
+            "required": [
+                "data_type",
+                "name"
+            ],
+            "properties": {
+                "data_type": {
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "int",
+                        "float",
+                        "boolean",
+                        "datetime",
+                        "json"
+                    ],
+                    "example": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Button ID"
+                },
+                "event_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "is_required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "button_id"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateEventLogBatchRequest": {
+            "type": "object",
+            "required": [
+                "events"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.EventLogBatchItemRequest"
+                    }
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateEventLogRequest": {
+            "type": "object",
+            "required": [
+                "platform_id",
+                "properties"
+            ],
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "event_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "message_id": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "example": "6f1c2d9e-3b7a-4c1e-9a0b-2f4e5d6c7b8a"
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "session_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "session_key": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "5f0c7a3e-8d21-4b6f-9e3a-1c2d3e4f5a6b"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateEventRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "is_active",
+                "name",
+                "platform_id"
+            ],
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Click on CTA"
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "click_button"
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateFunnelRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "steps",
+                "to"
+            ],
+            "properties": {
+                "breakdown_by": {
+                    "type": "string",
+                    "enum": [
+                        "platform",
+                        "property"
+                    ]
+                },
+                "breakdown_property": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "count_by": {
+                    "type": "string",
+                    "enum": [
+                        "session",
+                        "user"
+                    ],
+                    "example": "session"
+                },
+                "from": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1704067200
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "steps": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.FunnelStep"
+                    }
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 1704153600
+                },
+                "window_seconds": {
+                    "type": "integer",
+                    "maximum": 2592000,
+                    "minimum": 1,
+                    "example": 1800
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreatePathRequest": {
+            "type": "object",
+            "required": [
+                "anchor_event_id",
+                "from",
+                "to"
+            ],
+            "properties": {
+                "anchor_event_id": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "1231231123"
+                },
+                "depth": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 3
+                },
+                "direction": {
+                    "type": "string",
+                    "enum": [
+                        "before",
+                        "after"
+                    ],
+                    "example": "after"
+                },
+                "from": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1704067200
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 1704153600
+                },
+                "top_n": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 10
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateRetentionRequest": {
+            "type": "object",
+            "required": [
+                "cohort_event",
+                "from",
+                "return_event",
+                "to"
+            ],
+            "properties": {
+                "anonymous": {
+                    "type": "string",
+                    "enum": [
+                        "exclude",
+                        "session_key"
+                    ],
+                    "example": "exclude"
+                },
+                "cohort_event": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.RetentionEvent"
+                },
+                "from": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1704067200
+                },
+                "granularity": {
+                    "type": "string",
+                    "enum": [
+                        "day",
+                        "week"
+                    ],
+                    "example": "day"
+                },
+                "periods": {
+                    "type": "integer",
+                    "maximum": 90,
+                    "minimum": 1,
+                    "example": 7
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "return_event": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.RetentionEvent"
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 1704672000
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.CreateSessionRequest": {
+            "type": "object",
+            "required": [
+                "platform_id",
+                "session_key",
+                "started_at"
+            ],
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "ended_at": {
+                    "type": "string",
+                    "example": "2007-01-02 15:04:05"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "127.0.0.1"
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "session_key": {
+                    "type": "string",
+                    "example": "1231231123"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04:05"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/108.0.0.0 Safari/537.36"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.ErrorResponseWithCode": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "msg": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "default": false
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.Event": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "is_active": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
-                "tenant_id": {
-                    "type": "string"
+                "platform_id": {
+                    "type": "integer"
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "tracking-service_internal_datastructures.ApplicationAPIKey": {
+        "tracking-service_internal_datastructures.EventAnalyticsResponse": {
             "type": "object",
             "properties": {
-                "api_key": {
+                "application_id": {
                     "type": "string"
                 },
-                "application_id": {
+                "from": {
                     "type": "string"
                 },
+                "interval": {
+                    "type": "string"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.EventMetric"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.EventMetric"
+                    }
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.EventField": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
+                "data_type": {
+                    "type": "string"
+                },
                 "deleted_at": {
                     "type": "string"
                 },
+                "description": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "is_required": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "tracking-service_internal_datastructures.BaseResponse": {
+        "tracking-service_internal_datastructures.EventLog": {
             "type": "object",
             "properties": {
-                "data": {},
-                "msg": {
+                "application_id": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean",
-                    "default": true
-                }
-            }
-        },
-        "tracking-service_internal_datastructures.CreateEventFieldRequest": {
-            "type": "object",
-            "required": [
-                "data_type",
-                "name"
-            ],
-            "properties": {
-                "data_type": {
-                    "type": "string",
-                    "enum": [
-                        "string",
-                        "int",
-                        "float",
-                        "boolean",
-                        "datetime",
-                        "json"
-                    ],
-                    "example": "string"
+                "client_timestamp": {
+                    "type": "string"
                 },
-                "description": {
-                    "type": "string",
-                    "example": "Button ID"
+                "created_at": {
+                    "type": "string"
                 },
                 "event_id": {
-                    "type": "string",
-                    "example": "1231231123"
+                    "type": "string"
                 },
-                "is_required": {
-                    "type": "boolean",
-                    "example": true
+                "id": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "button_id"
+                "message_id": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "integer"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "session_key": {
+                    "type": "string"
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "tracking-service_internal_datastructures.CreateEventLogRequest": {
+        "tracking-service_internal_datastructures.EventLogBatchItemRequest": {
             "type": "object",
             "required": [
+                "client_timestamp",
+                "event_id",
                 "platform_id",
-                "properties",
-                "session_id"
+                "properties"
             ],
             "properties": {
-                "application_id": {
+                "client_timestamp": {
                     "type": "string",
-                    "example": "1231231123"
+                    "example": "2006-01-02 15:04:05"
                 },
                 "event_id": {
                     "type": "string",
                     "example": "1231231123"
                 },
+                "message_id": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "example": "6f1c2d9e-3b7a-4c1e-9a0b-2f4e5d6c7b8a"
+                },
                 "platform_id": {
                     "type": "integer",
                     "example": 1
@@ -3119,155 +6887,299 @@ const docTemplate = `{
                 "session_id": {
                     "type": "string",
                     "example": "1231231123"
+                },
+                "session_key": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "5f0c7a3e-8d21-4b6f-9e3a-1c2d3e4f5a6b"
                 }
             }
         },
-        "tracking-service_internal_datastructures.CreateEventRequest": {
+        "tracking-service_internal_datastructures.EventLogBatchResponse": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "rejected": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.EventLogBatchResult"
+                    }
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.EventLogBatchResult": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "event_log": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.EventLog"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "msg": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.EventMetric": {
+            "type": "object",
+            "properties": {
+                "breakdown": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "integer"
+                },
+                "sessions": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.EventResponse": {
             "type": "object",
-            "required": [
-                "description",
-                "is_active",
-                "name",
-                "platform_id"
-            ],
             "properties": {
                 "application_id": {
-                    "type": "string",
-                    "example": "1231231123"
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
                 },
                 "description": {
-                    "type": "string",
-                    "example": "Click on CTA"
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.EventField"
+                    }
+                },
+                "id": {
+                    "type": "string"
                 },
                 "is_active": {
-                    "type": "boolean",
-                    "example": true
+                    "type": "boolean"
                 },
                 "name": {
-                    "type": "string",
-                    "example": "click_button"
+                    "type": "string"
                 },
                 "platform_id": {
-                    "type": "integer",
-                    "example": 1
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.FunnelBreakdown": {
+            "type": "object",
+            "properties": {
+                "breakdown": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.FunnelStepResult"
+                    }
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.FunnelResponse": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string"
+                },
+                "breakdowns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.FunnelBreakdown"
+                    }
+                },
+                "count_by": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "window_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.FunnelStep": {
+            "type": "object",
+            "required": [
+                "event_id"
+            ],
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "1231231123"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "tracking-service_internal_datastructures.CreateSessionRequest": {
+        "tracking-service_internal_datastructures.FunnelStepResult": {
             "type": "object",
-            "required": [
-                "platform_id",
-                "session_key",
-                "started_at"
-            ],
             "properties": {
-                "application_id": {
-                    "type": "string",
-                    "example": "1231231123"
+                "conversion_rate": {
+                    "type": "number"
                 },
-                "ended_at": {
-                    "type": "string",
-                    "example": "2007-01-02 15:04:05"
+                "count": {
+                    "type": "integer"
                 },
-                "ip_address": {
-                    "type": "string",
-                    "example": "127.0.0.1"
+                "event_id": {
+                    "type": "string"
                 },
-                "platform_id": {
-                    "type": "integer",
-                    "example": 1
+                "median_seconds_from_previous": {
+                    "type": "number"
                 },
-                "session_key": {
-                    "type": "string",
-                    "example": "1231231123"
+                "step_conversion_rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.OutboxRedriveResponse": {
+            "type": "object",
+            "properties": {
+                "redriven": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.OutboxStats": {
+            "type": "object",
+            "properties": {
+                "delivered": {
+                    "type": "integer"
                 },
-                "started_at": {
-                    "type": "string",
-                    "example": "2006-01-02 15:04:05"
+                "failed": {
+                    "type": "integer"
                 },
-                "user_agent": {
-                    "type": "string",
-                    "example": "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/108.0.0.0 Safari/537.36"
+                "oldest_pending_at": {
+                    "type": "string"
                 },
-                "user_id": {
-                    "type": "string",
-                    "example": "1231231123"
+                "pending": {
+                    "type": "integer"
                 }
             }
         },
-        "tracking-service_internal_datastructures.ErrorResponseWithCode": {
+        "tracking-service_internal_datastructures.PathEdge": {
             "type": "object",
             "properties": {
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "sessions": {
+                    "type": "integer"
                 },
-                "msg": {
+                "source": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean",
-                    "default": false
+                "target": {
+                    "type": "string"
                 }
             }
         },
-        "tracking-service_internal_datastructures.Event": {
+        "tracking-service_internal_datastructures.PathNode": {
             "type": "object",
             "properties": {
-                "application_id": {
+                "event_id": {
                     "type": "string"
                 },
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "sessions": {
+                    "type": "integer"
+                },
+                "step": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.PathResponse": {
+            "type": "object",
+            "properties": {
+                "anchor_event_id": {
                     "type": "string"
                 },
-                "description": {
+                "application_id": {
                     "type": "string"
                 },
-                "id": {
+                "depth": {
+                    "type": "integer"
+                },
+                "direction": {
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.PathEdge"
+                    }
                 },
-                "name": {
+                "from": {
                     "type": "string"
                 },
-                "platform_id": {
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.PathNode"
+                    }
+                },
+                "sessions": {
                     "type": "integer"
                 },
-                "updated_at": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "tracking-service_internal_datastructures.EventField": {
+        "tracking-service_internal_datastructures.Platform": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "data_type": {
-                    "type": "string"
-                },
                 "deleted_at": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "event_id": {
-                    "type": "string"
-                },
                 "id": {
-                    "type": "string"
-                },
-                "is_required": {
-                    "type": "boolean"
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
@@ -3277,53 +7189,130 @@ const docTemplate = `{
                 }
             }
         },
-        "tracking-service_internal_datastructures.EventLog": {
+        "tracking-service_internal_datastructures.Quota": {
             "type": "object",
             "properties": {
-                "application_id": {
-                    "type": "string"
+                "monthly_event_logs": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1000000
                 },
-                "created_at": {
-                    "type": "string"
+                "monthly_sessions": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100000
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.QuotaUsage": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
                 },
-                "event_id": {
+                "metric": {
                     "type": "string"
                 },
-                "id": {
+                "used": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.RateLimit": {
+            "type": "object",
+            "properties": {
+                "burst": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 200
+                },
+                "per_second": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.RetentionCohort": {
+            "type": "object",
+            "properties": {
+                "cohort": {
                     "type": "string"
                 },
-                "platform_id": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "retained": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "size": {
                     "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.RetentionEvent": {
+            "type": "object",
+            "required": [
+                "event_id"
+            ],
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "1231231123"
                 },
                 "properties": {
                     "type": "object",
-                    "additionalProperties": true
-                },
-                "session_id": {
-                    "type": "string"
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "tracking-service_internal_datastructures.Platform": {
+        "tracking-service_internal_datastructures.RetentionResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "anonymous": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "application_id": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "cohorts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.RetentionCohort"
+                    }
                 },
-                "name": {
+                "from": {
                     "type": "string"
                 },
-                "updated_at": {
+                "granularity": {
+                    "type": "string"
+                },
+                "periods": {
+                    "type": "integer"
+                },
+                "to": {
                     "type": "string"
                 }
             }
         },
+        "tracking-service_internal_datastructures.RotateApplicationAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2006-01-02 15:04:05"
+                }
+            }
+        },
         "tracking-service_internal_datastructures.Session": {
             "type": "object",
             "properties": {
@@ -3365,6 +7354,61 @@ const docTemplate = `{
                 }
             }
         },
+        "tracking-service_internal_datastructures.SessionAnalyticsResponse": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string"
+                },
+                "breakdown_by": {
+                    "type": "string"
+                },
+                "breakdowns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.SessionMetric"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totals": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.SessionMetric"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.SessionMetric": {
+            "type": "object",
+            "properties": {
+                "avg_duration_seconds": {
+                    "type": "number"
+                },
+                "bounce_rate": {
+                    "type": "number"
+                },
+                "breakdown": {
+                    "type": "string"
+                },
+                "events_per_session": {
+                    "type": "number"
+                },
+                "p50_duration_seconds": {
+                    "type": "number"
+                },
+                "p90_duration_seconds": {
+                    "type": "number"
+                },
+                "p99_duration_seconds": {
+                    "type": "number"
+                },
+                "sessions": {
+                    "type": "integer"
+                }
+            }
+        },
         "tracking-service_internal_datastructures.Tenant": {
             "type": "object",
             "properties": {
@@ -3383,11 +7427,44 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "quota": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.Quota"
+                },
+                "rate_limit": {
+                    "$ref": "#/definitions/tracking-service_internal_datastructures.RateLimit"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
+        "tracking-service_internal_datastructures.UpdateAdminUserRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 12,
+                    "example": "correct-horse-battery"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "super_admin",
+                        "tenant_admin",
+                        "read_only"
+                    ],
+                    "example": "tenant_admin"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "1231231123"
+                }
+            }
+        },
         "tracking-service_internal_datastructures.UpdateEventFieldRequest": {
             "type": "object",
             "required": [
@@ -3466,10 +7543,63 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "tracking-service_internal_datastructures.Usage": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "application_id": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "rejected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tracking-service_internal_datastructures.UsageResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "quotas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.QuotaUsage"
+                    }
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "usages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tracking-service_internal_datastructures.Usage"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
+        "AdminApiKey": {
+            "description": "ADMIN_API_KEY，僅供建立後台使用者",
+            "type": "apiKey",
+            "name": "x-api-key",
+            "in": "header"
+        },
         "Bearer": {
+            "description": "後台使用者登入取得的 token，格式為 \"Bearer {token}\"",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
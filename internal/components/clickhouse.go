@@ -0,0 +1,88 @@
+package component
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	shared "tracking-service/internal"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Clickhouse 透過 HTTP 介面存取 ClickHouse
+type Clickhouse struct {
+	client *resty.Client
+}
+
+func NewClickhouse(config *shared.Config) *Clickhouse {
+	client := NewRestyClient().
+		SetBaseURL(config.ClickhouseEndpoint).
+		SetBasicAuth(config.ClickhouseUser, config.ClickhousePassword).
+		SetQueryParam("database", config.ClickhouseDb)
+
+	return &Clickhouse{
+		client: client,
+	}
+}
+
+// Insert 以 JSONEachRow 格式將資料批次寫入指定資料表
+func (c *Clickhouse) Insert(ctx context.Context, table string, rows []any) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return fmt.Errorf("encode clickhouse row failed: %w", err)
+		}
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("query", fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", table)).
+		SetBody(body.Bytes()).
+		Post("/")
+	if err != nil {
+		return fmt.Errorf("clickhouse insert failed: %w", err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("clickhouse insert failed: %s: %s", resp.Status(), resp.String())
+	}
+
+	return nil
+}
+
+// Query 以 {name:Type} 形式的具名參數執行唯讀查詢，回傳 JSONEachRow 格式的每一列
+func (c *Clickhouse) Query(ctx context.Context, query string, params map[string]string) ([]json.RawMessage, error) {
+	req := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("readonly", "2").
+		SetQueryParam("output_format_json_quote_64bit_integers", "0").
+		SetBody(query + " FORMAT JSONEachRow")
+	for name, value := range params {
+		req.SetQueryParam("param_"+name, value)
+	}
+
+	resp, err := req.Post("/")
+	if err != nil {
+		return nil, fmt.Errorf("clickhouse query failed: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("clickhouse query failed: %s: %s", resp.Status(), resp.String())
+	}
+
+	rows := make([]json.RawMessage, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(resp.Body()))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rows = append(rows, json.RawMessage(bytes.Clone(scanner.Bytes())))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read clickhouse response failed: %w", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,45 @@
+package component
+
+import (
+	"context"
+	"strings"
+	shared "tracking-service/internal"
+
+	"github.com/IBM/sarama"
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+func NewConsumerGroup(
+	lc fx.Lifecycle,
+	config *shared.Config,
+) sarama.ConsumerGroup {
+	kafkaVersion, err := sarama.ParseKafkaVersion(config.KafkaVersion)
+	if err != nil {
+		log.WithError(err).Fatalf("Error parsing Kafka version: %s", kafkaVersion)
+	}
+
+	// Setup Kafka consumer group，offset 由 worker 寫入成功後手動提交
+	log.Infof("Consumer group %s connecting to Kafka broker at %s", shared.KafkaGroupId, config.KafkaBrokers)
+	consumerConfig := sarama.NewConfig()
+	consumerConfig.Version = kafkaVersion
+	consumerConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	consumerConfig.Consumer.Offsets.AutoCommit.Enable = false
+	consumerConfig.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{
+		sarama.NewBalanceStrategySticky(),
+	}
+	group, err := sarama.NewConsumerGroup(strings.Split(config.KafkaBrokers, ","), shared.KafkaGroupId, consumerConfig)
+	if err != nil {
+		log.Panicf("Error creating consumer group: %v", err)
+	}
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info("Shutting down consumer group...")
+			return group.Close()
+		},
+	})
+	return group
+}
@@ -2,7 +2,6 @@ package component
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	shared "tracking-service/internal"
@@ -18,14 +17,7 @@ func NewDb(
 	lc fx.Lifecycle,
 	config *shared.Config,
 ) *gorm.DB {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable search_path=%s",
-		config.PostgresHost,
-		config.PostgresUser,
-		config.PostgresPassword,
-		config.PostgresDb,
-		config.PostgresPort,
-		config.PostgresSchema,
-	)
+	dsn := config.PostgresDSN()
 	db, err := gorm.Open(postgres.New(postgres.Config{
 		DSN:                  dsn,
 		PreferSimpleProtocol: true, // disables implicit prepared statement cache
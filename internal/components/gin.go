@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"runtime/debug"
+	"slices"
 	"strings"
 	"time"
 	shared "tracking-service/internal"
@@ -25,10 +26,14 @@ type RouteRegistrar interface {
 	RegisterRoutes(*gin.Engine)
 }
 
-func NewRouter(config *shared.Config, routeRegistrars []RouteRegistrar) *gin.Engine {
+func NewRouter(config *shared.Config, routeRegistrars []RouteRegistrar) (*gin.Engine, error) {
 	// 設定為 config 中的模式（可設定為 "debug" 或 "release"）
 	gin.SetMode(config.GinMode)
 	router := gin.Default()
+	// 僅信任設定的代理轉送的 X-Forwarded-For，避免 ClientIP 被偽造
+	if err := router.SetTrustedProxies(config.TrustedProxyList()); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
 	// 自訂 Recovery
 	router.Use(customRecovery())
 
@@ -41,6 +46,10 @@ func NewRouter(config *shared.Config, routeRegistrars []RouteRegistrar) *gin.Eng
 	// 設定 X-TRACE-ID Header
 	router.Use(traceIDHeaderMiddleware())
 
+	// 允許瀏覽器跨來源呼叫的路由，實際來源由 publishable 密鑰的允許清單把關
+	corsPaths := []string{"/tenant/"}
+	router.Use(corsMiddleware(corsPaths))
+
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggoFiles.Handler))
 
 	// 註冊所有的路由
@@ -48,7 +57,7 @@ func NewRouter(config *shared.Config, routeRegistrars []RouteRegistrar) *gin.Eng
 		registrar.RegisterRoutes(router)
 	}
 
-	return router
+	return router, nil
 }
 
 func customRecovery() gin.HandlerFunc {
@@ -109,6 +118,34 @@ func traceIDHeaderMiddleware() gin.HandlerFunc {
 	}
 }
 
+// corsMiddleware 回應預檢請求並回傳來源，API 以 x-api-key 驗證而非 cookie，因此不允許 credentials
+func corsMiddleware(paths []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" || !slices.ContainsFunc(paths, func(path string) bool {
+			return strings.HasPrefix(c.Request.URL.Path, path)
+		}) {
+			c.Next()
+			return
+		}
+
+		header := c.Writer.Header()
+		header.Set("Access-Control-Allow-Origin", origin)
+		header.Add("Vary", "Origin")
+		header.Set("Access-Control-Expose-Headers", "X-TRACE-ID, Retry-After, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, X-Quota-Limit, X-Quota-Remaining, X-Quota-Warning")
+
+		if c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != "" {
+			header.Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			header.Set("Access-Control-Allow-Headers", "Content-Type, X-Api-Key, Idempotency-Key, Traceparent, Tracestate")
+			header.Set("Access-Control-Max-Age", "600")
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+
 func customOtelMiddleware(serviceName string, notTracedEndpoints map[string][]string) gin.HandlerFunc {
 	// 產生過濾函式，判斷是否需要追蹤
 	filterTraces := func(req *http.Request) bool {
@@ -0,0 +1,73 @@
+package component_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	shared "tracking-service/internal"
+	component "tracking-service/internal/components"
+	middleware "tracking-service/internal/middlewares"
+	service "tracking-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type loginRoutes struct {
+	config             *shared.Config
+	rate_limit_service *service.RateLimitService
+}
+
+func (r *loginRoutes) RegisterRoutes(router *gin.Engine) {
+	router.POST("/admin/login",
+		middleware.AdminLoginRateLimitMiddleware(r.config, r.rate_limit_service),
+		func(c *gin.Context) { c.String(http.StatusOK, c.ClientIP()) },
+	)
+}
+
+func TestNewRouterIgnoresSpoofedForwardedFor(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies string
+		wantClientIP   string
+		wantStatus     int
+	}{
+		// 未設定代理時以連線來源計算，輪替 X-Forwarded-For 仍共用同一個桶
+		{name: "no trusted proxies", wantStatus: http.StatusTooManyRequests},
+		{name: "trusted proxy", trustedProxies: "10.0.0.0/8", wantClientIP: "203.0.113.99", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &shared.Config{GinMode: gin.TestMode, TrustedProxies: tt.trustedProxies, MaxRequestBodySize: 1 << 20}
+			routes := &loginRoutes{config: config, rate_limit_service: service.NewRateLimitService(nil)}
+			router, err := component.NewRouter(config, []component.RouteRegistrar{routes})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var w *httptest.ResponseRecorder
+			for i := 0; i < 100; i++ {
+				req := httptest.NewRequest(http.MethodPost, "/admin/login", strings.NewReader(fmt.Sprintf(`{"email":"user%d@example.com"}`, i)))
+				req.RemoteAddr = "10.0.0.1:12345"
+				req.Header.Set("X-Forwarded-For", fmt.Sprintf("203.0.113.%d", i))
+				w = httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+			}
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && w.Body.String() != tt.wantClientIP {
+				t.Errorf("ClientIP = %q, want %q", w.Body.String(), tt.wantClientIP)
+			}
+		})
+	}
+}
+
+func TestNewRouterInvalidTrustedProxies(t *testing.T) {
+	if _, err := component.NewRouter(&shared.Config{GinMode: gin.TestMode, TrustedProxies: "not-an-ip"}, nil); err == nil {
+		t.Error("NewRouter() error = nil, want error for invalid trusted proxies")
+	}
+}
@@ -96,5 +96,5 @@ func NewMeterProvider(
 			return mp.Shutdown(ctx)
 		},
 	})
-	return nil
+	return mp
 }
@@ -1,6 +1,7 @@
 package component
 
 import (
+	"net/url"
 	"regexp"
 	"time"
 
@@ -27,6 +28,9 @@ func NewValidator() *validator.Validate {
 	// 註冊 datetime_format 驗證器
 	_ = v.RegisterValidation("datetime_format", datetimeFormat)
 
+	// 註冊 origin 驗證器
+	_ = v.RegisterValidation("origin", origin)
+
 	return v
 }
 
@@ -38,3 +42,15 @@ func datetimeFormat(fl validator.FieldLevel) bool {
 	_, err := time.Parse("2006-01-02 15:04:05", dateStr)
 	return err == nil
 }
+
+// origin 僅接受 scheme://host[:port]，host 可為 *.example.com 形式的萬用字元
+func origin(fl validator.FieldLevel) bool {
+	u, err := url.Parse(fl.Field().String())
+	if err != nil {
+		return false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+	return u.Host != "" && (u.Path == "" || u.Path == "/") && u.RawQuery == "" && u.Fragment == "" && u.User == nil
+}
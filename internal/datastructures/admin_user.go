@@ -0,0 +1,47 @@
+package datastructure
+
+type AdminUser struct {
+	ID          string  `json:"id"`
+	Email       string  `json:"email"`
+	Role        string  `json:"role"`
+	TenantID    *string `json:"tenant_id"`
+	LastLoginAt string  `json:"last_login_at"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	DeletedAt   string  `json:"deleted_at"`
+}
+
+// CreateAdminUserRequest tenant_admin 必須指定 tenant_id，read_only 指定時僅能檢視該租戶
+type CreateAdminUserRequest struct {
+	Email    string  `json:"email" example:"admin@example.com" binding:"required,email,max=255"`
+	Password string  `json:"password" example:"correct-horse-battery" binding:"required,min=12,max=72"`
+	Role     string  `json:"role" example:"tenant_admin" binding:"required,oneof=super_admin tenant_admin read_only"`
+	TenantID *string `json:"tenant_id" example:"1231231123" binding:"omitempty"`
+}
+
+// UpdateAdminUserRequest 未帶 password 時保留原密碼，異動後先前簽發的 token 失效
+type UpdateAdminUserRequest struct {
+	Password string  `json:"password" example:"correct-horse-battery" binding:"omitempty,min=12,max=72"`
+	Role     string  `json:"role" example:"tenant_admin" binding:"required,oneof=super_admin tenant_admin read_only"`
+	TenantID *string `json:"tenant_id" example:"1231231123" binding:"omitempty"`
+}
+
+type AdminLoginRequest struct {
+	Email    string `json:"email" example:"admin@example.com" binding:"required,email"`
+	Password string `json:"password" example:"correct-horse-battery" binding:"required"`
+}
+
+type AdminLoginResponse struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresAt   string    `json:"expires_at"`
+	User        AdminUser `json:"user"`
+}
+
+// AdminPrincipal 為目前請求的身分，以 ADMIN_API_KEY 驗證時 role 為 bootstrap
+type AdminPrincipal struct {
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	TenantID string `json:"tenant_id"`
+}
@@ -0,0 +1,188 @@
+package datastructure
+
+// AnalyticsFilter 為分析共用的時間範圍與篩選條件，from 與 to 為 Unix 秒數，範圍為 [from, to)
+// properties 以 properties[key]=value 帶入，非字串的屬性值以 JSON 表示比對，例如 properties[paid]=true
+type AnalyticsFilter struct {
+	From       int64             `form:"from" binding:"required,min=0"`
+	To         int64             `form:"to" binding:"required,gtfield=From"`
+	EventID    string            `form:"event_id" binding:"omitempty,max=32"`
+	PlatformID int               `form:"platform_id" binding:"omitempty,min=1"`
+	Properties map[string]string `form:"-"`
+}
+
+// GetEventAnalyticsRequest interval 預設 hour，breakdown_by 為 property 時須帶入 breakdown_property
+type GetEventAnalyticsRequest struct {
+	AnalyticsFilter
+	Interval          string `form:"interval" binding:"omitempty,oneof=minute hour day"`
+	BreakdownBy       string `form:"breakdown_by" binding:"omitempty,oneof=platform property"`
+	BreakdownProperty string `form:"breakdown_property" binding:"required_if=BreakdownBy property,max=255"`
+}
+
+// EventMetric 未分時間區間時省略 bucket，未分組時省略 breakdown
+type EventMetric struct {
+	Bucket    string `json:"bucket,omitempty"`
+	Breakdown string `json:"breakdown,omitempty"`
+	Events    int64  `json:"events"`
+	Sessions  int64  `json:"sessions"`
+	Users     int64  `json:"users"`
+}
+
+// EventAnalyticsResponse totals 為整段時間的彙總，不重複數無法由 series 加總取得
+type EventAnalyticsResponse struct {
+	ApplicationID string         `json:"application_id"`
+	From          string         `json:"from"`
+	To            string         `json:"to"`
+	Interval      string         `json:"interval"`
+	Totals        []*EventMetric `json:"totals"`
+	Series        []*EventMetric `json:"series"`
+}
+
+// FunnelStep properties 為此步驟事件須符合的屬性值，非字串的屬性值以 JSON 表示比對
+type FunnelStep struct {
+	EventID    string            `json:"event_id" example:"1231231123" binding:"required,max=32"`
+	Properties map[string]string `json:"properties"`
+}
+
+// CreateFunnelRequest from 與 to 為 Unix 秒數，window_seconds 預設 1800，count_by 預設 session
+type CreateFunnelRequest struct {
+	From              int64         `json:"from" example:"1704067200" binding:"required,min=0"`
+	To                int64         `json:"to" example:"1704153600" binding:"required,gtfield=From"`
+	Steps             []*FunnelStep `json:"steps" binding:"required,min=2,max=10,dive"`
+	WindowSeconds     int64         `json:"window_seconds" example:"1800" binding:"omitempty,min=1,max=2592000"`
+	PlatformID        int           `json:"platform_id" binding:"omitempty,min=1"`
+	CountBy           string        `json:"count_by" example:"session" binding:"omitempty,oneof=session user"`
+	BreakdownBy       string        `json:"breakdown_by" binding:"omitempty,oneof=platform property"`
+	BreakdownProperty string        `json:"breakdown_property" binding:"required_if=BreakdownBy property,max=255"`
+}
+
+// FunnelStepResult conversion_rate 為相對第一步的轉換率，step_conversion_rate 為相對前一步的轉換率
+// median_seconds_from_previous 為與前一步驟間隔秒數的中位數，第一步或無人完成時省略
+type FunnelStepResult struct {
+	EventID                   string   `json:"event_id"`
+	Count                     int64    `json:"count"`
+	ConversionRate            float64  `json:"conversion_rate"`
+	StepConversionRate        float64  `json:"step_conversion_rate"`
+	MedianSecondsFromPrevious *float64 `json:"median_seconds_from_previous,omitempty"`
+}
+
+type FunnelBreakdown struct {
+	Breakdown string              `json:"breakdown,omitempty"`
+	Steps     []*FunnelStepResult `json:"steps"`
+}
+
+type FunnelResponse struct {
+	ApplicationID string             `json:"application_id"`
+	From          string             `json:"from"`
+	To            string             `json:"to"`
+	WindowSeconds int64              `json:"window_seconds"`
+	CountBy       string             `json:"count_by"`
+	Breakdowns    []*FunnelBreakdown `json:"breakdowns"`
+}
+
+// RetentionEvent properties 為事件須符合的屬性值，非字串的屬性值以 JSON 表示比對
+type RetentionEvent struct {
+	EventID    string            `json:"event_id" example:"1231231123" binding:"required,max=32"`
+	Properties map[string]string `json:"properties"`
+}
+
+// CreateRetentionRequest from 與 to 為 cohort 事件的時間範圍 (Unix 秒數)，回訪事件查詢至 to 之後 periods 個週期
+// granularity 預設 day，periods 預設 7，anonymous 預設 exclude，session_key 時以 session 計算沒有 user_id 的 session
+type CreateRetentionRequest struct {
+	From        int64           `json:"from" example:"1704067200" binding:"required,min=0"`
+	To          int64           `json:"to" example:"1704672000" binding:"required,gtfield=From"`
+	CohortEvent *RetentionEvent `json:"cohort_event" binding:"required"`
+	ReturnEvent *RetentionEvent `json:"return_event" binding:"required"`
+	Granularity string          `json:"granularity" example:"day" binding:"omitempty,oneof=day week"`
+	Periods     int             `json:"periods" example:"7" binding:"omitempty,min=1,max=90"`
+	PlatformID  int             `json:"platform_id" binding:"omitempty,min=1"`
+	Anonymous   string          `json:"anonymous" example:"exclude" binding:"omitempty,oneof=exclude session_key"`
+}
+
+// RetentionCohort retained 與 rates 的第 k 筆為在 cohort 後第 k 個週期回訪的人數與比例，第 0 筆為同一週期
+type RetentionCohort struct {
+	Cohort   string    `json:"cohort"`
+	Size     int64     `json:"size"`
+	Retained []int64   `json:"retained"`
+	Rates    []float64 `json:"rates"`
+}
+
+type RetentionResponse struct {
+	ApplicationID string             `json:"application_id"`
+	From          string             `json:"from"`
+	To            string             `json:"to"`
+	Granularity   string             `json:"granularity"`
+	Periods       int                `json:"periods"`
+	Anonymous     string             `json:"anonymous"`
+	Cohorts       []*RetentionCohort `json:"cohorts"`
+}
+
+// CreatePathRequest from 與 to 為 Unix 秒數，properties 為錨點事件須符合的屬性值
+// direction 預設 after，depth 預設 3，top_n 預設 10
+type CreatePathRequest struct {
+	From          int64             `json:"from" example:"1704067200" binding:"required,min=0"`
+	To            int64             `json:"to" example:"1704153600" binding:"required,gtfield=From"`
+	AnchorEventID string            `json:"anchor_event_id" example:"1231231123" binding:"required,max=32"`
+	Properties    map[string]string `json:"properties"`
+	Direction     string            `json:"direction" example:"after" binding:"omitempty,oneof=before after"`
+	Depth         int               `json:"depth" example:"3" binding:"omitempty,min=1,max=10"`
+	TopN          int               `json:"top_n" example:"10" binding:"omitempty,min=1,max=100"`
+	PlatformID    int               `json:"platform_id" binding:"omitempty,min=1"`
+}
+
+// PathNode id 為 {step}:{event_id}，step 為與錨點事件相距的步數，之前的事件為負數
+type PathNode struct {
+	ID       string `json:"id"`
+	Step     int    `json:"step"`
+	EventID  string `json:"event_id"`
+	Sessions int64  `json:"sessions"`
+}
+
+// PathEdge 依事件發生順序由 source 指向 target
+type PathEdge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Sessions int64  `json:"sessions"`
+}
+
+// PathResponse sessions 為觸發錨點事件的 session 數，nodes 與 edges 僅由數量最多的 top_n 條路徑組成
+type PathResponse struct {
+	ApplicationID string      `json:"application_id"`
+	From          string      `json:"from"`
+	To            string      `json:"to"`
+	AnchorEventID string      `json:"anchor_event_id"`
+	Direction     string      `json:"direction"`
+	Depth         int         `json:"depth"`
+	Sessions      int64       `json:"sessions"`
+	Nodes         []*PathNode `json:"nodes"`
+	Edges         []*PathEdge `json:"edges"`
+}
+
+// GetSessionAnalyticsRequest from 與 to 為 session 開始時間的範圍 (Unix 秒數)，範圍為 [from, to)
+// breakdown_by 為 day 時 breakdown 為 UTC 當日起點的 Unix 秒數，user_agent 時為瀏覽器家族
+type GetSessionAnalyticsRequest struct {
+	From        int64  `form:"from" binding:"required,min=0"`
+	To          int64  `form:"to" binding:"required,gtfield=From"`
+	PlatformID  int    `form:"platform_id" binding:"omitempty,min=1"`
+	BreakdownBy string `form:"breakdown_by" binding:"omitempty,oneof=platform day user_agent"`
+}
+
+// SessionMetric 時長以秒數表示，bounce_rate 為只有一筆事件的 session 比例，未分組時省略 breakdown
+type SessionMetric struct {
+	Breakdown          string  `json:"breakdown,omitempty"`
+	Sessions           int64   `json:"sessions"`
+	BounceRate         float64 `json:"bounce_rate"`
+	EventsPerSession   float64 `json:"events_per_session"`
+	AvgDurationSeconds float64 `json:"avg_duration_seconds"`
+	P50DurationSeconds float64 `json:"p50_duration_seconds"`
+	P90DurationSeconds float64 `json:"p90_duration_seconds"`
+	P99DurationSeconds float64 `json:"p99_duration_seconds"`
+}
+
+type SessionAnalyticsResponse struct {
+	ApplicationID string           `json:"application_id"`
+	From          string           `json:"from"`
+	To            string           `json:"to"`
+	BreakdownBy   string           `json:"breakdown_by,omitempty"`
+	Totals        *SessionMetric   `json:"totals"`
+	Breakdowns    []*SessionMetric `json:"breakdowns"`
+}
@@ -1,32 +1,66 @@
 package datastructure
 
 type Application struct {
-	ID          string `json:"id"`
-	TenantID    string `json:"tenant_id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
-	DeletedAt   string `json:"deleted_at"`
+	ID                    string              `json:"id"`
+	TenantID              string              `json:"tenant_id"`
+	Name                  string              `json:"name"`
+	Description           string              `json:"description"`
+	AllowedOrigins        []string            `json:"allowed_origins"`
+	RateLimit             *RateLimit          `json:"rate_limit,omitempty"`
+	SessionTimeoutSeconds *int                `json:"session_timeout_seconds,omitempty"`
+	IngestionValidation   *string             `json:"ingestion_validation,omitempty"`
+	APIKeys               []ApplicationAPIKey `json:"api_keys,omitempty"`
+	CreatedAt             string              `json:"created_at"`
+	UpdatedAt             string              `json:"updated_at"`
+	DeletedAt             string              `json:"deleted_at"`
 }
 
 type ApplicationAPIKey struct {
-	ID            string `json:"id"`
-	ApplicationID string `json:"application_id"`
-	APIKey        string `json:"api_key"`
-	CreatedAt     string `json:"created_at"`
-	UpdatedAt     string `json:"updated_at"`
-	DeletedAt     string `json:"deleted_at"`
+	ID            string   `json:"id"`
+	ApplicationID string   `json:"application_id"`
+	KeyPrefix     string   `json:"key_prefix"`
+	Type          string   `json:"type"`
+	APIKey        string   `json:"api_key,omitempty"`
+	Scopes        []string `json:"scopes"`
+	ExpiresAt     string   `json:"expires_at"`
+	LastUsedAt    string   `json:"last_used_at"`
+	RevokedAt     string   `json:"revoked_at"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+	DeletedAt     string   `json:"deleted_at"`
 }
 
+// CreateApplicationRequest AllowedOrigins 為 publishable 密鑰允許的瀏覽器來源，未帶 RateLimit 時不限制
+// SessionTimeoutSeconds 未帶入或為 0 時使用服務預設的閒置逾時，-1 時不逾時，其餘須至少 60 秒，IngestionValidation 未帶入時為 reject
 type CreateApplicationRequest struct {
-	TenantID    string `json:"tenant_id" example:"1231231123" binding:"required"`
-	Name        string `json:"name" example:"My App" binding:"required"`
-	Description string `json:"description" example:"My App Description" binding:"required"`
+	TenantID              string     `json:"tenant_id" example:"1231231123" binding:"required"`
+	Name                  string     `json:"name" example:"My App" binding:"required"`
+	Description           string     `json:"description" example:"My App Description" binding:"required"`
+	AllowedOrigins        []string   `json:"allowed_origins" example:"https://example.com" binding:"omitempty,max=100,dive,origin"`
+	RateLimit             *RateLimit `json:"rate_limit"`
+	SessionTimeoutSeconds *int       `json:"session_timeout_seconds" example:"1800" binding:"omitempty,eq=-1|eq=0|min=60,max=604800"`
+	IngestionValidation   *string    `json:"ingestion_validation" example:"reject" binding:"omitempty,oneof=reject tag"`
 }
 
+// UpdateApplicationRequest 未帶 AllowedOrigins、RateLimit、SessionTimeoutSeconds 或 IngestionValidation 時保留原設定
 type UpdateApplicationRequest struct {
-	TenantID    string `json:"tenant_id" example:"1231231123" binding:"required"`
-	Name        string `json:"name" example:"My App" binding:"required"`
-	Description string `json:"description" example:"My App Description" binding:"required"`
+	TenantID              string     `json:"tenant_id" example:"1231231123" binding:"required"`
+	Name                  string     `json:"name" example:"My App" binding:"required"`
+	Description           string     `json:"description" example:"My App Description" binding:"required"`
+	AllowedOrigins        []string   `json:"allowed_origins" example:"https://example.com" binding:"omitempty,max=100,dive,origin"`
+	RateLimit             *RateLimit `json:"rate_limit"`
+	SessionTimeoutSeconds *int       `json:"session_timeout_seconds" example:"1800" binding:"omitempty,eq=-1|eq=0|min=60,max=604800"`
+	IngestionValidation   *string    `json:"ingestion_validation" example:"reject" binding:"omitempty,oneof=reject tag"`
+}
+
+// CreateApplicationAPIKeyRequest 未指定類型時為 secret，未指定權限範圍時授予該類型的全部權限，未指定到期時間時套用預設有效期
+type CreateApplicationAPIKeyRequest struct {
+	Type      string   `json:"type" example:"publishable" binding:"omitempty,oneof=secret publishable"`
+	Scopes    []string `json:"scopes" example:"ingest" binding:"omitempty,dive,oneof=ingest schema:read schema:write sessions"`
+	ExpiresAt string   `json:"expires_at" example:"2006-01-02 15:04:05" binding:"omitempty,datetime_format"`
+}
+
+// RotateApplicationAPIKeyRequest 新密鑰的到期時間，未指定時套用預設有效期
+type RotateApplicationAPIKeyRequest struct {
+	ExpiresAt string `json:"expires_at" example:"2006-01-02 15:04:05" binding:"omitempty,datetime_format"`
 }
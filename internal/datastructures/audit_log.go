@@ -0,0 +1,32 @@
+package datastructure
+
+type AuditLog struct {
+	ID            string                 `json:"id"`
+	TenantID      *string                `json:"tenant_id"`
+	ApplicationID *string                `json:"application_id"`
+	ActorType     string                 `json:"actor_type"`
+	ActorID       string                 `json:"actor_id"`
+	Action        string                 `json:"action"`
+	ResourceType  string                 `json:"resource_type"`
+	ResourceID    string                 `json:"resource_id"`
+	Before        map[string]interface{} `json:"before"`
+	After         map[string]interface{} `json:"after"`
+	TraceID       string                 `json:"trace_id"`
+	ClientIP      string                 `json:"client_ip"`
+	CreatedAt     string                 `json:"created_at"`
+}
+
+// GetAuditLogsRequest cursor 帶入上一頁回應的 next_cursor，from 與 to 為 Unix 秒數，limit 預設 50、最多 200，與其他列表相同
+type GetAuditLogsRequest struct {
+	Cursor        string `form:"cursor" binding:"omitempty,max=32"`
+	TenantID      string `form:"tenant_id" binding:"omitempty,max=32"`
+	ApplicationID string `form:"application_id" binding:"omitempty,max=32"`
+	ActorType     string `form:"actor_type" binding:"omitempty,oneof=admin_user bootstrap api_key system"`
+	ActorID       string `form:"actor_id" binding:"omitempty,max=64"`
+	Action        string `form:"action" binding:"omitempty,oneof=create update delete rotate revoke"`
+	ResourceType  string `form:"resource_type" binding:"omitempty,oneof=tenant platform application api_key event event_field"`
+	ResourceID    string `form:"resource_id" binding:"omitempty,max=32"`
+	From          int64  `form:"from" binding:"omitempty,min=0"`
+	To            int64  `form:"to" binding:"omitempty,min=0"`
+	Limit         int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
@@ -25,13 +25,23 @@ type EventField struct {
 }
 
 type EventLog struct {
-	ID            string                 `json:"id"`
-	ApplicationID string                 `json:"application_id"`
-	SessionID     string                 `json:"session_id"`
-	EventID       string                 `json:"event_id"`
-	PlatformID    int                    `json:"platform_id"`
-	Properties    map[string]interface{} `json:"properties"`
-	CreatedAt     string                 `json:"created_at"`
+	ID              string                 `json:"id"`
+	MessageID       string                 `json:"message_id,omitempty"`
+	TenantID        string                 `json:"tenant_id,omitempty"`
+	ApplicationID   string                 `json:"application_id"`
+	SessionID       string                 `json:"session_id"`
+	SessionKey      string                 `json:"session_key,omitempty"`
+	EventID         string                 `json:"event_id"`
+	PlatformID      int                    `json:"platform_id"`
+	Properties      map[string]interface{} `json:"properties"`
+	ClientTimestamp string                 `json:"client_timestamp,omitempty"`
+	CreatedAt       string                 `json:"created_at"`
+	// 以 session_key 建立 session 時使用的請求來源資訊
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+	// 應用程式的參照驗證設定，tag 時違反項目寫入 Violations
+	IngestionValidation string   `json:"-"`
+	Violations          []string `json:"violations,omitempty"`
 }
 
 type EventResponse struct {
@@ -71,10 +81,43 @@ type UpdateEventFieldRequest struct {
 	Description string `json:"description" example:"Button ID" binding:"omitempty"`
 }
 
+// CreateEventLogRequest 未帶 session_id 時以 session_key 取得或建立 session，兩者皆帶入時以 session_id 為準
 type CreateEventLogRequest struct {
 	ApplicationID string                 `json:"application_id" example:"1231231123" binding:"omitempty"`
 	PlatformID    int                    `json:"platform_id" example:"1" binding:"required"`
 	EventID       string                 `json:"event_id" example:"1231231123" binding:"omitempty"`
-	SessionID     string                 `json:"session_id" example:"1231231123" binding:"required"`
+	SessionID     string                 `json:"session_id" example:"1231231123" binding:"required_without=SessionKey"`
+	SessionKey    string                 `json:"session_key" example:"5f0c7a3e-8d21-4b6f-9e3a-1c2d3e4f5a6b" binding:"required_without=SessionID,max=255"`
 	Properties    map[string]interface{} `json:"properties" binding:"required"`
+	MessageID     string                 `json:"message_id" example:"6f1c2d9e-3b7a-4c1e-9a0b-2f4e5d6c7b8a" binding:"omitempty,max=128"`
+}
+
+// EventLogBatchItemRequest 未帶 session_id 時以 session_key 取得或建立 session
+type EventLogBatchItemRequest struct {
+	EventID         string                 `json:"event_id" example:"1231231123" binding:"required"`
+	SessionID       string                 `json:"session_id" example:"1231231123" binding:"required_without=SessionKey"`
+	SessionKey      string                 `json:"session_key" example:"5f0c7a3e-8d21-4b6f-9e3a-1c2d3e4f5a6b" binding:"required_without=SessionID,max=255"`
+	PlatformID      int                    `json:"platform_id" example:"1" binding:"required"`
+	Properties      map[string]interface{} `json:"properties" binding:"required"`
+	ClientTimestamp string                 `json:"client_timestamp" example:"2006-01-02 15:04:05" binding:"required,datetime_format"`
+	MessageID       string                 `json:"message_id" example:"6f1c2d9e-3b7a-4c1e-9a0b-2f4e5d6c7b8a" binding:"omitempty,max=128"`
+}
+
+type CreateEventLogBatchRequest struct {
+	Events []EventLogBatchItemRequest `json:"events" binding:"required,min=1"`
+}
+
+type EventLogBatchResult struct {
+	Index    int               `json:"index"`
+	Success  bool              `json:"success"`
+	EventLog *EventLog         `json:"event_log,omitempty"`
+	Message  string            `json:"msg,omitempty"`
+	Code     string            `json:"code,omitempty"`
+	Details  map[string]string `json:"details,omitempty"`
+}
+
+type EventLogBatchResponse struct {
+	Accepted int                   `json:"accepted"`
+	Rejected int                   `json:"rejected"`
+	Results  []EventLogBatchResult `json:"results"`
 }
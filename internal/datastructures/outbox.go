@@ -0,0 +1,12 @@
+package datastructure
+
+type OutboxStats struct {
+	Pending         int64  `json:"pending"`
+	Failed          int64  `json:"failed"`
+	Delivered       int64  `json:"delivered"`
+	OldestPendingAt string `json:"oldest_pending_at"`
+}
+
+type OutboxRedriveResponse struct {
+	Redriven int64 `json:"redriven"`
+}
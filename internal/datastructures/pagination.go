@@ -0,0 +1,23 @@
+package datastructure
+
+// ListRequest 為列表共用的分頁、排序與篩選條件
+// cursor 帶入上一頁回應的 next_cursor，created_from 與 created_to 為 Unix 秒數，limit 預設 50
+type ListRequest struct {
+	Cursor      string `form:"cursor" binding:"omitempty,max=32"`
+	Limit       int    `form:"limit" binding:"omitempty,min=1,max=200"`
+	Sort        string `form:"sort" binding:"omitempty,oneof=created_at -created_at name -name"`
+	Name        string `form:"name" binding:"omitempty,max=255"`
+	CreatedFrom int64  `form:"created_from" binding:"omitempty,min=0"`
+	CreatedTo   int64  `form:"created_to" binding:"omitempty,min=0"`
+}
+
+type GetApplicationsRequest struct {
+	ListRequest
+	TenantID string `form:"tenant_id" binding:"omitempty,max=32"`
+}
+
+type GetEventsRequest struct {
+	ListRequest
+	PlatformID int   `form:"platform_id" binding:"omitempty,min=1"`
+	IsActive   *bool `form:"is_active"`
+}
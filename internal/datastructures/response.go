@@ -1,9 +1,11 @@
 package datastructure
 
+// BaseResponse 列表回應有下一頁時帶入 next_cursor
 type BaseResponse struct {
-	Success bool        `json:"success" default:"true"`
-	Message string      `json:"msg" default:""`
-	Data    interface{} `json:"data,omitempty"`
+	Success    bool        `json:"success" default:"true"`
+	Message    string      `json:"msg" default:""`
+	Data       interface{} `json:"data,omitempty"`
+	NextCursor string      `json:"next_cursor,omitempty"`
 }
 
 type ErrorResponse struct {
@@ -11,7 +13,9 @@ type ErrorResponse struct {
 	Message string `json:"msg"`
 }
 
+// ErrorResponseWithCode Code 為可供程式判斷的錯誤代碼，僅部分錯誤帶入
 type ErrorResponseWithCode struct {
 	ErrorResponse
+	Code    string            `json:"code,omitempty"`
 	Details map[string]string `json:"details"`
 }
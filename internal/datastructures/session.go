@@ -30,3 +30,7 @@ type UpdateSessionRequest struct {
 	UserID  string `json:"user_id" binding:"omitempty"`
 	EndedAt string `json:"ended_at" binding:"omitempty,datetime_format"`
 }
+
+type GetSessionByKeyRequest struct {
+	SessionKey string `form:"session_key" binding:"required,max=255"`
+}
@@ -1,20 +1,40 @@
 package datastructure
 
 type Tenant struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
-	DeletedAt   string `json:"deleted_at"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	RateLimit   *RateLimit `json:"rate_limit,omitempty"`
+	Quota       *Quota     `json:"quota,omitempty"`
+	CreatedAt   string     `json:"created_at"`
+	UpdatedAt   string     `json:"updated_at"`
+	DeletedAt   string     `json:"deleted_at"`
 }
 
+// RateLimit 為令牌桶設定，per_second 為 0 代表不限制，burst 為 0 時等同 per_second
+type RateLimit struct {
+	PerSecond int `json:"per_second" example:"100" binding:"min=0"`
+	Burst     int `json:"burst" example:"200" binding:"min=0"`
+}
+
+// Quota 為每月可接受的用量上限，0 代表不限制
+type Quota struct {
+	MonthlyEventLogs int64 `json:"monthly_event_logs" example:"1000000" binding:"min=0"`
+	MonthlySessions  int64 `json:"monthly_sessions" example:"100000" binding:"min=0"`
+}
+
+// CreateTenantRequest 未帶 RateLimit、Quota 時不限制
 type CreateTenantRequest struct {
-	Name        string `json:"name" example:"My Shop" binding:"required"`
-	Description string `json:"description" example:"Shopping Description" binding:"required"`
+	Name        string     `json:"name" example:"My Shop" binding:"required"`
+	Description string     `json:"description" example:"Shopping Description" binding:"required"`
+	RateLimit   *RateLimit `json:"rate_limit"`
+	Quota       *Quota     `json:"quota"`
 }
 
+// UpdateTenantRequest 未帶 RateLimit、Quota 時保留原設定
 type UpdateTenantRequest struct {
-	Name        string `json:"name" example:"My Shop" binding:"required"`
-	Description string `json:"description" example:"Shopping Description" binding:"required"`
+	Name        string     `json:"name" example:"My Shop" binding:"required"`
+	Description string     `json:"description" example:"Shopping Description" binding:"required"`
+	RateLimit   *RateLimit `json:"rate_limit"`
+	Quota       *Quota     `json:"quota"`
 }
@@ -0,0 +1,31 @@
+package datastructure
+
+// GetUsageRequest 日期以 UTC 計算，未帶入時為當月一日至今日
+type GetUsageRequest struct {
+	From          string `form:"from" example:"2024-01-01" binding:"omitempty,datetime=2006-01-02"`
+	To            string `form:"to" example:"2024-01-31" binding:"omitempty,datetime=2006-01-02"`
+	ApplicationID string `form:"application_id" example:"1231231123" binding:"omitempty"`
+}
+
+type Usage struct {
+	Date          string `json:"date"`
+	ApplicationID string `json:"application_id"`
+	Metric        string `json:"metric"`
+	Accepted      int64  `json:"accepted"`
+	Rejected      int64  `json:"rejected"`
+}
+
+// QuotaUsage 為當月用量，limit 為 0 代表不限制
+type QuotaUsage struct {
+	Metric string `json:"metric"`
+	Limit  int64  `json:"limit"`
+	Used   int64  `json:"used"`
+}
+
+type UsageResponse struct {
+	TenantID string        `json:"tenant_id"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Usages   []*Usage      `json:"usages"`
+	Quotas   []*QuotaUsage `json:"quotas"`
+}
@@ -14,8 +14,71 @@ var (
 	ErrorForbidden      = errors.New("forbidden")
 	ErrorInternalError  = errors.New("internal error")
 	ErrorDuplicateKey   = errors.New("duplicate key")
+	ErrorRateLimited    = errors.New("rate limit exceeded")
+	ErrorQuotaExceeded  = errors.New("quota exceeded")
+	ErrorBodyTooLarge   = errors.New("request body too large")
 )
 
+// ValidationError 帶有逐欄位錯誤說明的無效請求
+type ValidationError struct {
+	Details map[string]string
+}
+
+func NewValidationError(details map[string]string) *ValidationError {
+	return &ValidationError{Details: details}
+}
+
+func (e *ValidationError) Error() string {
+	return ErrorInvalidRequest.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrorInvalidRequest
+}
+
+// CodedError 帶有錯誤代碼的錯誤，Unwrap 為對應回應狀態的一般錯誤
+type CodedError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+func (e *CodedError) Error() string {
+	return e.Message
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Cause
+}
+
+// 事件日誌寫入時的參照驗證錯誤，Code 亦用於標記未拒絕的事件日誌
+var (
+	ErrorEventNotFound         = &CodedError{Code: "event_not_found", Message: "event not found", Cause: ErrorNotFound}
+	ErrorEventInactive         = &CodedError{Code: "event_inactive", Message: "event is inactive", Cause: ErrorInvalidRequest}
+	ErrorEventPlatformMismatch = &CodedError{Code: "event_platform_mismatch", Message: "platform does not match event", Cause: ErrorInvalidRequest}
+	ErrorSessionNotFound       = &CodedError{Code: "session_not_found", Message: "session not found", Cause: ErrorNotFound}
+	ErrorSessionEnded          = &CodedError{Code: "session_ended", Message: "session has ended", Cause: ErrorInvalidRequest}
+)
+
+// ErrorRateLimitExceedsBurst 寫入數量超過令牌桶容量，重試也無法通過，須拆分請求
+var ErrorRateLimitExceedsBurst = &CodedError{Code: "rate_limit_exceeds_burst", Message: "request exceeds the rate limit burst, split it into smaller requests", Cause: ErrorRateLimited}
+
+// ErrorCodeInvalidRequest 為欄位驗證失敗（ValidationError）的錯誤代碼
+const ErrorCodeInvalidRequest = "invalid_request"
+
+// ErrorCode 取得錯誤代碼，ValidationError 為 invalid_request，其餘非 CodedError 時回傳空字串
+func ErrorCode(err error) string {
+	var codedErr *CodedError
+	if errors.As(err, &codedErr) {
+		return codedErr.Code
+	}
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return ErrorCodeInvalidRequest
+	}
+	return ""
+}
+
 func WrapGormError(err error) error {
 	if err == nil {
 		return nil
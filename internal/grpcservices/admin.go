@@ -0,0 +1,1082 @@
+package grpcservice
+
+import (
+	"context"
+	"net/netip"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	trackingv1 "tracking-service/internal/pb/tracking/v1"
+	service "tracking-service/internal/services"
+	util "tracking-service/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	adminSuperRoles = []string{shared.AdminRoleSuperAdmin}
+	adminWriteRoles = []string{shared.AdminRoleSuperAdmin, shared.AdminRoleTenantAdmin}
+	adminReadRoles  = []string{shared.AdminRoleSuperAdmin, shared.AdminRoleTenantAdmin, shared.AdminRoleReadOnly}
+)
+
+// TrackingAdminService 以 gRPC 提供 /admin 路由中的設定管理功能，後台使用者管理與分析查詢僅提供 HTTP
+type TrackingAdminService struct {
+	trackingv1.UnimplementedTrackingAdminServiceServer
+	config             *shared.Config
+	tenant_service     *service.TenantService
+	platform_service   *service.PlatformService
+	app_service        *service.ApplicationService
+	event_service      *service.EventService
+	outbox_service     *service.OutboxService
+	usage_service      *service.UsageService
+	admin_user_service *service.AdminUserService
+	audit_service      *service.AuditService
+	trusted_proxies    []netip.Prefix
+}
+
+func NewTrackingAdminService(
+	config *shared.Config,
+	tenant_service *service.TenantService,
+	platform_service *service.PlatformService,
+	app_service *service.ApplicationService,
+	event_service *service.EventService,
+	outbox_service *service.OutboxService,
+	usage_service *service.UsageService,
+	admin_user_service *service.AdminUserService,
+	audit_service *service.AuditService,
+) *TrackingAdminService {
+	return &TrackingAdminService{
+		config:             config,
+		tenant_service:     tenant_service,
+		platform_service:   platform_service,
+		app_service:        app_service,
+		event_service:      event_service,
+		outbox_service:     outbox_service,
+		usage_service:      usage_service,
+		admin_user_service: admin_user_service,
+		audit_service:      audit_service,
+		trusted_proxies:    parseTrustedProxies(config.TrustedProxyList()),
+	}
+}
+
+func (s *TrackingAdminService) Register(server *grpc.Server) {
+	trackingv1.RegisterTrackingAdminServiceServer(server, s)
+}
+
+func (s *TrackingAdminService) CreateTenant(ctx context.Context, req *trackingv1.CreateTenantRequest) (*trackingv1.Tenant, error) {
+	ctx, _, err := s.authenticate(ctx, adminSuperRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateTenantRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		RateLimit:   fromRateLimit(req.GetRateLimit()),
+		Quota:       fromQuota(req.GetQuota()),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	tenant, err := s.tenant_service.CreateTenant(ctx, &datastructure.Tenant{
+		Name:        in.Name,
+		Description: in.Description,
+		RateLimit:   in.RateLimit,
+		Quota:       in.Quota,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toTenant(tenant), nil
+}
+
+func (s *TrackingAdminService) GetTenant(ctx context.Context, req *trackingv1.GetTenantRequest) (*trackingv1.Tenant, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeTenant(principal, req.GetTenantId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	tenant, err := s.tenant_service.GetTenant(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toTenant(tenant), nil
+}
+
+func (s *TrackingAdminService) UpdateTenant(ctx context.Context, req *trackingv1.UpdateTenantRequest) (*emptypb.Empty, error) {
+	ctx, _, err := s.authenticate(ctx, adminSuperRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.UpdateTenantRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		RateLimit:   fromRateLimit(req.GetRateLimit()),
+		Quota:       fromQuota(req.GetQuota()),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	err = s.tenant_service.UpdateTenant(ctx, req.GetTenantId(), &datastructure.Tenant{
+		Name:        in.Name,
+		Description: in.Description,
+		RateLimit:   in.RateLimit,
+		Quota:       in.Quota,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) ListTenants(ctx context.Context, req *trackingv1.ListTenantsRequest) (*trackingv1.ListTenantsResponse, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := toListRequest(req.GetOptions())
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 限定租戶的使用者僅回傳所屬租戶
+	tenants, nextCursor, err := s.tenant_service.GetTenants(ctx, principal.TenantID, &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.ListTenantsResponse{
+		Tenants:    make([]*trackingv1.Tenant, 0, len(tenants)),
+		NextCursor: nextCursor,
+	}
+	for _, tenant := range tenants {
+		resp.Tenants = append(resp.Tenants, toTenant(tenant))
+	}
+	return resp, nil
+}
+
+func (s *TrackingAdminService) GetTenantUsage(ctx context.Context, req *trackingv1.GetTenantUsageRequest) (*trackingv1.TenantUsage, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeTenant(principal, req.GetTenantId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.GetUsageRequest{
+		From:          req.GetFrom(),
+		To:            req.GetTo(),
+		ApplicationID: req.GetApplicationId(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	report, err := s.usage_service.GetUsage(ctx, req.GetTenantId(), &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.TenantUsage{
+		TenantId: req.GetTenantId(),
+		From:     report.From.Format(time.DateOnly),
+		To:       report.To.Format(time.DateOnly),
+		Usages:   make([]*trackingv1.Usage, 0, len(report.Usages)),
+		Quotas:   make([]*trackingv1.QuotaUsage, 0, len(report.Quotas)),
+	}
+	for _, usage := range report.Usages {
+		resp.Usages = append(resp.Usages, &trackingv1.Usage{
+			Date:          usage.UsageDate.Format(time.DateOnly),
+			ApplicationId: usage.ApplicationID,
+			Metric:        usage.Metric,
+			Accepted:      usage.Accepted,
+			Rejected:      usage.Rejected,
+		})
+	}
+	for _, quota := range report.Quotas {
+		resp.Quotas = append(resp.Quotas, &trackingv1.QuotaUsage{
+			Metric: quota.Metric,
+			Limit:  quota.Limit,
+			Used:   quota.Used,
+		})
+	}
+	return resp, nil
+}
+
+func (s *TrackingAdminService) CreatePlatform(ctx context.Context, req *trackingv1.CreatePlatformRequest) (*trackingv1.Platform, error) {
+	ctx, _, err := s.authenticate(ctx, adminSuperRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreatePlatformRequest{
+		Name: req.GetName(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	platform, err := s.platform_service.CreatePlatform(ctx, &datastructure.Platform{
+		Name: in.Name,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toPlatform(platform), nil
+}
+
+func (s *TrackingAdminService) GetPlatform(ctx context.Context, req *trackingv1.GetPlatformRequest) (*trackingv1.Platform, error) {
+	ctx, _, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	platform, err := s.platform_service.GetPlatformByID(ctx, int(req.GetPlatformId()))
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toPlatform(platform), nil
+}
+
+func (s *TrackingAdminService) ListPlatforms(ctx context.Context, req *trackingv1.ListPlatformsRequest) (*trackingv1.ListPlatformsResponse, error) {
+	ctx, _, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := toListRequest(req.GetOptions())
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	platforms, nextCursor, err := s.platform_service.GetPlatforms(ctx, &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.ListPlatformsResponse{
+		Platforms:  make([]*trackingv1.Platform, 0, len(platforms)),
+		NextCursor: nextCursor,
+	}
+	for _, platform := range platforms {
+		resp.Platforms = append(resp.Platforms, toPlatform(platform))
+	}
+	return resp, nil
+}
+
+func (s *TrackingAdminService) CreateApp(ctx context.Context, req *trackingv1.CreateAppRequest) (*trackingv1.Application, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeTenant(principal, req.GetTenantId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateApplicationRequest{
+		TenantID:              req.GetTenantId(),
+		Name:                  req.GetName(),
+		Description:           req.GetDescription(),
+		AllowedOrigins:        req.GetAllowedOrigins(),
+		RateLimit:             fromRateLimit(req.GetRateLimit()),
+		SessionTimeoutSeconds: fromOptionalInt32(req.SessionTimeoutSeconds),
+		IngestionValidation:   req.IngestionValidation,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	app, err := s.app_service.CreateApplication(ctx, &datastructure.Application{
+		TenantID:              in.TenantID,
+		Name:                  in.Name,
+		Description:           in.Description,
+		AllowedOrigins:        in.AllowedOrigins,
+		RateLimit:             in.RateLimit,
+		SessionTimeoutSeconds: in.SessionTimeoutSeconds,
+		IngestionValidation:   in.IngestionValidation,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toApplication(app), nil
+}
+
+func (s *TrackingAdminService) GetApp(ctx context.Context, req *trackingv1.GetAppRequest) (*trackingv1.Application, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	app, err := s.app_service.GetApplicationByID(ctx, req.GetAppId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toApplication(app), nil
+}
+
+func (s *TrackingAdminService) UpdateApp(ctx context.Context, req *trackingv1.UpdateAppRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeApplicationTenant(ctx, principal, req.GetAppId(), req.GetTenantId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.UpdateApplicationRequest{
+		TenantID:              req.GetTenantId(),
+		Name:                  req.GetName(),
+		Description:           req.GetDescription(),
+		RateLimit:             fromRateLimit(req.GetRateLimit()),
+		SessionTimeoutSeconds: fromOptionalInt32(req.SessionTimeoutSeconds),
+		IngestionValidation:   req.IngestionValidation,
+	}
+	if req.AllowedOrigins != nil {
+		in.AllowedOrigins = append([]string{}, req.GetAllowedOrigins().GetOrigins()...)
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	err = s.app_service.UpdateApplicationByID(ctx, req.GetAppId(), &datastructure.Application{
+		ID:                    req.GetAppId(),
+		TenantID:              in.TenantID,
+		Name:                  in.Name,
+		Description:           in.Description,
+		AllowedOrigins:        in.AllowedOrigins,
+		RateLimit:             in.RateLimit,
+		SessionTimeoutSeconds: in.SessionTimeoutSeconds,
+		IngestionValidation:   in.IngestionValidation,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) DeleteApp(ctx context.Context, req *trackingv1.DeleteAppRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	if err := s.app_service.DeleteApplicationByID(ctx, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) ListApps(ctx context.Context, req *trackingv1.ListAppsRequest) (*trackingv1.ListAppsResponse, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.GetApplicationsRequest{
+		ListRequest: toListRequest(req.GetOptions()),
+		TenantID:    req.GetTenantId(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 限定租戶的使用者僅回傳所屬租戶的應用程式
+	apps, nextCursor, err := s.app_service.GetApplications(ctx, principal.TenantID, &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.ListAppsResponse{
+		Apps:       make([]*trackingv1.Application, 0, len(apps)),
+		NextCursor: nextCursor,
+	}
+	for _, app := range apps {
+		resp.Apps = append(resp.Apps, toApplication(app))
+	}
+	return resp, nil
+}
+
+func (s *TrackingAdminService) CreateAppAPIKey(ctx context.Context, req *trackingv1.CreateAppAPIKeyRequest) (*trackingv1.ApplicationAPIKey, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateApplicationAPIKeyRequest{
+		Type:      req.GetType(),
+		Scopes:    req.GetScopes(),
+		ExpiresAt: req.GetExpiresAt(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	apiKey, err := s.app_service.CreateApplicationAPIKey(ctx, req.GetAppId(), &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toApplicationAPIKey(apiKey), nil
+}
+
+func (s *TrackingAdminService) DeleteAppAPIKey(ctx context.Context, req *trackingv1.DeleteAppAPIKeyRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	if err := s.app_service.DeleteApplicationAPIKey(ctx, req.GetAppId(), req.GetApiKeyId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) RotateAppAPIKey(ctx context.Context, req *trackingv1.RotateAppAPIKeyRequest) (*trackingv1.ApplicationAPIKey, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.RotateApplicationAPIKeyRequest{
+		ExpiresAt: req.GetExpiresAt(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	apiKey, err := s.app_service.RotateApplicationAPIKey(ctx, req.GetAppId(), req.GetApiKeyId(), &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toApplicationAPIKey(apiKey), nil
+}
+
+func (s *TrackingAdminService) RevokeAppAPIKey(ctx context.Context, req *trackingv1.RevokeAppAPIKeyRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	if err := s.app_service.RevokeApplicationAPIKey(ctx, req.GetAppId(), req.GetApiKeyId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) CreateEvent(ctx context.Context, req *trackingv1.CreateEventRequest) (*trackingv1.Event, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetApplicationId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateEventRequest{
+		ApplicationID: req.GetApplicationId(),
+		PlatformID:    int(req.GetPlatformId()),
+		Name:          req.GetName(),
+		Description:   req.GetDescription(),
+		IsActive:      req.GetIsActive(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	event, err := s.event_service.CreateEvent(ctx, &datastructure.Event{
+		ApplicationID: in.ApplicationID,
+		PlatformID:    in.PlatformID,
+		Name:          in.Name,
+		Description:   in.Description,
+		IsActive:      in.IsActive,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toEvent(event), nil
+}
+
+func (s *TrackingAdminService) GetEvent(ctx context.Context, req *trackingv1.GetEventRequest) (*trackingv1.Event, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	event, err := s.event_service.GetEventByID(ctx, req.GetEventId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toEvent(event), nil
+}
+
+func (s *TrackingAdminService) UpdateEvent(ctx context.Context, req *trackingv1.UpdateEventRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.UpdateEventRequest{
+		PlatformID:  int(req.GetPlatformId()),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		IsActive:    req.GetIsActive(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	err = s.event_service.UpdateEventByID(ctx, req.GetEventId(), &datastructure.Event{
+		ID:          req.GetEventId(),
+		PlatformID:  in.PlatformID,
+		Name:        in.Name,
+		Description: in.Description,
+		IsActive:    in.IsActive,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) DeleteEvent(ctx context.Context, req *trackingv1.DeleteEventRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	if err := s.event_service.DeleteEventByID(ctx, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) ListEvents(ctx context.Context, req *trackingv1.ListEventsRequest) (*trackingv1.ListEventsResponse, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.GetEventsRequest{
+		ListRequest: toListRequest(req.GetOptions()),
+		PlatformID:  int(req.GetPlatformId()),
+		IsActive:    req.IsActive,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 限定租戶的使用者僅回傳所屬租戶應用程式的事件
+	var (
+		events     []*model.Event
+		nextCursor string
+	)
+	if req.GetAppId() != "" {
+		if err := s.admin_user_service.AuthorizeApplication(ctx, principal, req.GetAppId()); err != nil {
+			return nil, toStatusError(ctx, err)
+		}
+		events, nextCursor, err = s.event_service.GetEventsByApplicationID(ctx, req.GetAppId(), &in)
+	} else {
+		events, nextCursor, err = s.event_service.GetEvents(ctx, principal.TenantID, &in)
+	}
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.ListEventsResponse{
+		Events:     make([]*trackingv1.Event, 0, len(events)),
+		NextCursor: nextCursor,
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, toEvent(event))
+	}
+	return resp, nil
+}
+
+func (s *TrackingAdminService) CreateEventField(ctx context.Context, req *trackingv1.CreateEventFieldRequest) (*trackingv1.EventField, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateEventFieldRequest{
+		EventID:     req.GetEventId(),
+		Name:        req.GetName(),
+		DataType:    req.GetDataType(),
+		IsRequired:  req.GetIsRequired(),
+		Description: req.GetDescription(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	field, err := s.event_service.CreateEventField(ctx, &datastructure.EventField{
+		EventID:     in.EventID,
+		Name:        in.Name,
+		DataType:    in.DataType,
+		IsRequired:  in.IsRequired,
+		Description: in.Description,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toEventField(field), nil
+}
+
+func (s *TrackingAdminService) GetEventField(ctx context.Context, req *trackingv1.GetEventFieldRequest) (*trackingv1.EventField, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	field, err := s.event_service.GetEventFieldByEventIDAndID(ctx, req.GetEventId(), req.GetFieldId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toEventField(field), nil
+}
+
+func (s *TrackingAdminService) UpdateEventField(ctx context.Context, req *trackingv1.UpdateEventFieldRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.UpdateEventFieldRequest{
+		EventID:     req.GetEventId(),
+		Name:        req.GetName(),
+		DataType:    req.GetDataType(),
+		IsRequired:  req.GetIsRequired(),
+		Description: req.GetDescription(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	err = s.event_service.UpdateEventFieldByEventIDAndID(ctx, req.GetEventId(), req.GetFieldId(), &datastructure.EventField{
+		EventID:     in.EventID,
+		Name:        in.Name,
+		DataType:    in.DataType,
+		IsRequired:  in.IsRequired,
+		Description: in.Description,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) DeleteEventField(ctx context.Context, req *trackingv1.DeleteEventFieldRequest) (*emptypb.Empty, error) {
+	ctx, principal, err := s.authenticate(ctx, adminWriteRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	if err := s.event_service.DeleteEventFieldByEventIDAndID(ctx, req.GetEventId(), req.GetFieldId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackingAdminService) ListEventFields(ctx context.Context, req *trackingv1.ListEventFieldsRequest) (*trackingv1.ListEventFieldsResponse, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.authorizeEvent(ctx, principal, req.GetEventId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := toListRequest(req.GetOptions())
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	fields, nextCursor, err := s.event_service.GetEventFields(ctx, req.GetEventId(), &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.ListEventFieldsResponse{
+		Fields:     make([]*trackingv1.EventField, 0, len(fields)),
+		NextCursor: nextCursor,
+	}
+	for _, field := range fields {
+		resp.Fields = append(resp.Fields, toEventField(field))
+	}
+	return resp, nil
+}
+
+func (s *TrackingAdminService) GetOutboxStats(ctx context.Context, _ *emptypb.Empty) (*trackingv1.OutboxStats, error) {
+	ctx, _, err := s.authenticate(ctx, adminSuperRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	stats, err := s.outbox_service.GetOutboxStats(ctx)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &trackingv1.OutboxStats{
+		Pending:         stats.Pending,
+		Failed:          stats.Failed,
+		Delivered:       stats.Delivered,
+		OldestPendingAt: util.ConvertTimeToTimeStamp(stats.OldestPendingAt),
+	}, nil
+}
+
+func (s *TrackingAdminService) RedriveOutbox(ctx context.Context, _ *emptypb.Empty) (*trackingv1.RedriveOutboxResponse, error) {
+	ctx, _, err := s.authenticate(ctx, adminSuperRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	redriven, err := s.outbox_service.RedriveOutbox(ctx)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &trackingv1.RedriveOutboxResponse{Redriven: redriven}, nil
+}
+
+func (s *TrackingAdminService) ListAuditLogs(ctx context.Context, req *trackingv1.ListAuditLogsRequest) (*trackingv1.ListAuditLogsResponse, error) {
+	ctx, principal, err := s.authenticate(ctx, adminReadRoles...)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.GetAuditLogsRequest{
+		TenantID:      req.GetTenantId(),
+		ApplicationID: req.GetApplicationId(),
+		ActorType:     req.GetActorType(),
+		ActorID:       req.GetActorId(),
+		Action:        req.GetAction(),
+		ResourceType:  req.GetResourceType(),
+		ResourceID:    req.GetResourceId(),
+		From:          req.GetFrom(),
+		To:            req.GetTo(),
+		Cursor:        req.GetCursor(),
+		Limit:         int(req.GetLimit()),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	// 限定租戶的使用者僅能查詢所屬租戶
+	auditLogs, nextCursor, err := s.audit_service.GetAuditLogs(ctx, principal.TenantID, "", &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	resp := &trackingv1.ListAuditLogsResponse{
+		AuditLogs:  make([]*trackingv1.AuditLog, 0, len(auditLogs)),
+		NextCursor: nextCursor,
+	}
+	for _, auditLog := range auditLogs {
+		respAuditLog, err := toAuditLog(auditLog)
+		if err != nil {
+			return nil, toStatusError(ctx, err)
+		}
+		resp.AuditLogs = append(resp.AuditLogs, respAuditLog)
+	}
+	return resp, nil
+}
+
+// authenticate 等同 AdminAuthMiddleware 與 RequireAdminRole，優先以 authorization metadata 中的 Bearer token 驗證，回傳帶有稽核操作者的 context
+func (s *TrackingAdminService) authenticate(ctx context.Context, roles ...string) (context.Context, *service.AdminPrincipal, error) {
+	var (
+		principal *service.AdminPrincipal
+		err       error
+	)
+	if authorization := metadataValue(ctx, authorizationMetadataKey); authorization != "" {
+		token, parseErr := util.ParseBearerToken(authorization)
+		if parseErr != nil {
+			return ctx, nil, errdefs.ErrorUnauthorized
+		}
+		principal, err = s.admin_user_service.Authenticate(ctx, token)
+	} else {
+		principal, err = s.admin_user_service.AuthenticateAPIKey(apiKeyFromContext(ctx))
+	}
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	if !principal.HasRole(roles...) {
+		return ctx, nil, errdefs.ErrorForbidden
+	}
+	return service.WithAuditActor(ctx, principal.AuditActor(clientIPFromContext(ctx, s.trusted_proxies))), principal, nil
+}
+
+// authorizeApplicationTenant 限定租戶的使用者不可將應用程式移至其他租戶
+func (s *TrackingAdminService) authorizeApplicationTenant(ctx context.Context, principal *service.AdminPrincipal, appID string, tenantID string) error {
+	if err := s.admin_user_service.AuthorizeApplication(ctx, principal, appID); err != nil {
+		return err
+	}
+	return s.admin_user_service.AuthorizeTenant(principal, tenantID)
+}
+
+// authorizeEvent 事件 RPC 僅帶 event_id，以事件所屬應用程式判斷租戶
+func (s *TrackingAdminService) authorizeEvent(ctx context.Context, principal *service.AdminPrincipal, eventID string) error {
+	if principal.TenantID == "" {
+		return nil
+	}
+
+	event, err := s.event_service.GetEventByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	return s.admin_user_service.AuthorizeApplication(ctx, principal, event.ApplicationID)
+}
+
+func toListRequest(options *trackingv1.ListOptions) datastructure.ListRequest {
+	return datastructure.ListRequest{
+		Cursor:      options.GetCursor(),
+		Limit:       int(options.GetLimit()),
+		Sort:        options.GetSort(),
+		Name:        options.GetName(),
+		CreatedFrom: options.GetCreatedFrom(),
+		CreatedTo:   options.GetCreatedTo(),
+	}
+}
+
+func toTenant(tenant *model.Tenant) *trackingv1.Tenant {
+	return &trackingv1.Tenant{
+		Id:          tenant.ID,
+		Name:        tenant.Name,
+		Description: tenant.Description,
+		RateLimit:   toRateLimit(tenant.RateLimit),
+		Quota:       toQuota(tenant.Quota),
+		CreatedAt:   util.ConvertTimeToTimeStamp(&tenant.CreatedAt),
+		UpdatedAt:   util.ConvertTimeToTimeStamp(&tenant.UpdatedAt),
+		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(tenant.DeletedAt),
+	}
+}
+
+func toPlatform(platform *model.Platform) *trackingv1.Platform {
+	return &trackingv1.Platform{
+		Id:        int32(platform.ID),
+		Name:      platform.Name,
+		CreatedAt: util.ConvertTimeToTimeStamp(&platform.CreatedAt),
+		UpdatedAt: util.ConvertTimeToTimeStamp(&platform.UpdatedAt),
+		DeletedAt: util.ConvertGormDeletedAtToTimeStamp(platform.DeletedAt),
+	}
+}
+
+func toApplication(app *model.Application) *trackingv1.Application {
+	apiKeys := make([]*trackingv1.ApplicationAPIKey, 0, len(app.ApiKeys))
+	for i := range app.ApiKeys {
+		apiKeys = append(apiKeys, toApplicationAPIKey(&app.ApiKeys[i]))
+	}
+
+	return &trackingv1.Application{
+		Id:                    app.ID,
+		TenantId:              app.TenantID,
+		Name:                  app.Name,
+		Description:           app.Description,
+		AllowedOrigins:        app.AllowedOrigins,
+		RateLimit:             toRateLimit(app.RateLimit),
+		SessionTimeoutSeconds: int32(app.SessionTimeoutSeconds),
+		IngestionValidation:   app.IngestionValidation,
+		CreatedAt:             util.ConvertTimeToTimeStamp(&app.CreatedAt),
+		UpdatedAt:             util.ConvertTimeToTimeStamp(&app.UpdatedAt),
+		DeletedAt:             util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
+		ApiKeys:               apiKeys,
+	}
+}
+
+// toApplicationAPIKey 完整密鑰只存在於剛建立的 model，其餘情況僅有前綴
+func toApplicationAPIKey(apiKey *model.ApplicationApiKey) *trackingv1.ApplicationAPIKey {
+	return &trackingv1.ApplicationAPIKey{
+		Id:            apiKey.ID,
+		ApplicationId: apiKey.ApplicationID,
+		ApiKey:        apiKey.APIKey,
+		KeyPrefix:     apiKey.KeyPrefix,
+		Type:          apiKey.Type,
+		Scopes:        apiKey.Scopes,
+		ExpiresAt:     util.ConvertTimeToTimeStamp(apiKey.ExpiresAt),
+		LastUsedAt:    util.ConvertTimeToTimeStamp(apiKey.LastUsedAt),
+		RevokedAt:     util.ConvertTimeToTimeStamp(apiKey.RevokedAt),
+		CreatedAt:     util.ConvertTimeToTimeStamp(&apiKey.CreatedAt),
+		UpdatedAt:     util.ConvertTimeToTimeStamp(&apiKey.UpdatedAt),
+		DeletedAt:     util.ConvertGormDeletedAtToTimeStamp(apiKey.DeletedAt),
+	}
+}
+
+func toEvent(event *model.Event) *trackingv1.Event {
+	return &trackingv1.Event{
+		Id:            event.ID,
+		ApplicationId: event.ApplicationID,
+		PlatformId:    int32(event.PlatformID),
+		Name:          event.Name,
+		Description:   event.Description,
+		IsActive:      event.IsActive,
+		CreatedAt:     util.ConvertTimeToTimeStamp(&event.CreatedAt),
+		UpdatedAt:     util.ConvertTimeToTimeStamp(&event.UpdatedAt),
+		DeletedAt:     util.ConvertGormDeletedAtToTimeStamp(event.DeletedAt),
+	}
+}
+
+func toEventField(field *model.EventField) *trackingv1.EventField {
+	return &trackingv1.EventField{
+		Id:          field.ID,
+		EventId:     field.EventID,
+		Name:        field.Name,
+		DataType:    field.DataType,
+		IsRequired:  field.IsRequired,
+		Description: field.Description,
+		CreatedAt:   util.ConvertTimeToTimeStamp(&field.CreatedAt),
+		UpdatedAt:   util.ConvertTimeToTimeStamp(&field.UpdatedAt),
+		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(field.DeletedAt),
+	}
+}
+
+func toRateLimit(rateLimit model.RateLimit) *trackingv1.RateLimit {
+	return &trackingv1.RateLimit{
+		PerSecond: int32(rateLimit.PerSecond),
+		Burst:     int32(rateLimit.Burst),
+	}
+}
+
+// fromRateLimit 未帶入時回傳 nil，代表保留原設定
+func fromRateLimit(rateLimit *trackingv1.RateLimit) *datastructure.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	return &datastructure.RateLimit{
+		PerSecond: int(rateLimit.GetPerSecond()),
+		Burst:     int(rateLimit.GetBurst()),
+	}
+}
+
+func toQuota(quota model.Quota) *trackingv1.Quota {
+	return &trackingv1.Quota{
+		MonthlyEventLogs: quota.MonthlyEventLogs,
+		MonthlySessions:  quota.MonthlySessions,
+	}
+}
+
+// fromQuota 未帶入時回傳 nil，代表保留原設定
+func fromQuota(quota *trackingv1.Quota) *datastructure.Quota {
+	if quota == nil {
+		return nil
+	}
+	return &datastructure.Quota{
+		MonthlyEventLogs: quota.GetMonthlyEventLogs(),
+		MonthlySessions:  quota.GetMonthlySessions(),
+	}
+}
+
+// fromOptionalInt32 未帶入時回傳 nil，代表保留原設定
+func fromOptionalInt32(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+func toAuditLog(auditLog *model.AuditLog) (*trackingv1.AuditLog, error) {
+	resp := &trackingv1.AuditLog{
+		Id:           auditLog.ID,
+		ActorType:    auditLog.ActorType,
+		ActorId:      auditLog.ActorID,
+		Action:       auditLog.Action,
+		ResourceType: auditLog.ResourceType,
+		ResourceId:   auditLog.ResourceID,
+		TraceId:      auditLog.TraceID,
+		ClientIp:     auditLog.ClientIP,
+		CreatedAt:    util.ConvertTimeToTimeStamp(&auditLog.CreatedAt),
+	}
+	if auditLog.TenantID != nil {
+		resp.TenantId = *auditLog.TenantID
+	}
+	if auditLog.ApplicationID != nil {
+		resp.ApplicationId = *auditLog.ApplicationID
+	}
+	if auditLog.Before != nil {
+		before, err := structpb.NewStruct(auditLog.Before)
+		if err != nil {
+			return nil, errdefs.ErrorInternalError
+		}
+		resp.Before = before
+	}
+	if auditLog.After != nil {
+		after, err := structpb.NewStruct(auditLog.After)
+		if err != nil {
+			return nil, errdefs.ErrorInternalError
+		}
+		resp.After = after
+	}
+	return resp, nil
+}
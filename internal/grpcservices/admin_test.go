@@ -0,0 +1,195 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	model "tracking-service/internal/models"
+	trackingv1 "tracking-service/internal/pb/tracking/v1"
+	repository "tracking-service/internal/repositories"
+	service "tracking-service/internal/services"
+
+	"github.com/bwmarrin/snowflake"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testAdminPassword = "password"
+
+type fakeAdminUserRepository struct {
+	repository.AdminUserRepository
+	users map[string]*model.AdminUser
+}
+
+func (r *fakeAdminUserRepository) GetAdminUserByID(_ context.Context, id string) (*model.AdminUser, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (r *fakeAdminUserRepository) GetAdminUserByEmail(_ context.Context, email string) (*model.AdminUser, error) {
+	user, ok := r.users[email]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+	return user, nil
+}
+
+func (r *fakeAdminUserRepository) UpdateAdminUserLastLoginAt(context.Context, string, time.Time) error {
+	return nil
+}
+
+func newTestAdminService(t *testing.T) (*TrackingAdminService, map[string]string) {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(testAdminPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, err := snowflake.NewNode(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tenantID := "tenant-1"
+	repo := &fakeAdminUserRepository{users: map[string]*model.AdminUser{}}
+	for i, user := range []struct {
+		role     string
+		tenantID *string
+	}{
+		{role: shared.AdminRoleSuperAdmin},
+		{role: shared.AdminRoleTenantAdmin, tenantID: &tenantID},
+		{role: shared.AdminRoleReadOnly, tenantID: &tenantID},
+	} {
+		repo.users[user.role] = &model.AdminUser{
+			ID:           string(rune('1' + i)),
+			Email:        user.role,
+			PasswordHash: string(hash),
+			Role:         user.role,
+			TenantID:     user.tenantID,
+			UpdatedAt:    time.Now().Add(-time.Minute),
+		}
+	}
+
+	config := &shared.Config{
+		AdminApiKey:    "bootstrap-key",
+		AdminJWTSecret: strings.Repeat("s", 32),
+		AdminJWTTTL:    time.Hour,
+	}
+	admin_user_service := service.NewAdminUserService(config, node, repo, nil, nil)
+
+	tokens := make(map[string]string, len(repo.users))
+	for role := range repo.users {
+		token, _, _, err := admin_user_service.Login(context.Background(), &datastructure.AdminLoginRequest{Email: role, Password: testAdminPassword})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens[role] = token
+	}
+
+	return NewTrackingAdminService(config, nil, nil, nil, nil, nil, nil, admin_user_service, nil), tokens
+}
+
+// 與 RequireAdminRole、RequireAdminTenantAccess 相同，於呼叫服務層之前拒絕
+func TestTrackingAdminServiceAuthorization(t *testing.T) {
+	s, tokens := newTestAdminService(t)
+
+	tests := []struct {
+		name     string
+		role     string
+		call     func(ctx context.Context) error
+		wantCode codes.Code
+	}{
+		{
+			name: "bootstrap key cannot list tenants", role: shared.AdminRoleBootstrap,
+			call: func(ctx context.Context) error {
+				_, err := s.ListTenants(ctx, &trackingv1.ListTenantsRequest{})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "tenant admin cannot create tenants", role: shared.AdminRoleTenantAdmin,
+			call: func(ctx context.Context) error {
+				_, err := s.CreateTenant(ctx, &trackingv1.CreateTenantRequest{Name: "tenant"})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "tenant admin cannot read other tenants", role: shared.AdminRoleTenantAdmin,
+			call: func(ctx context.Context) error {
+				_, err := s.GetTenant(ctx, &trackingv1.GetTenantRequest{TenantId: "tenant-2"})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "read only cannot read other tenants usage", role: shared.AdminRoleReadOnly,
+			call: func(ctx context.Context) error {
+				_, err := s.GetTenantUsage(ctx, &trackingv1.GetTenantUsageRequest{TenantId: "tenant-2"})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "read only cannot create apps", role: shared.AdminRoleReadOnly,
+			call: func(ctx context.Context) error {
+				_, err := s.CreateApp(ctx, &trackingv1.CreateAppRequest{TenantId: "tenant-1", Name: "app"})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "tenant admin cannot create apps in other tenants", role: shared.AdminRoleTenantAdmin,
+			call: func(ctx context.Context) error {
+				_, err := s.CreateApp(ctx, &trackingv1.CreateAppRequest{TenantId: "tenant-2", Name: "app"})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "tenant admin cannot redrive outbox", role: shared.AdminRoleTenantAdmin,
+			call: func(ctx context.Context) error {
+				_, err := s.RedriveOutbox(ctx, nil)
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "missing credential", role: "",
+			call: func(ctx context.Context) error {
+				_, err := s.GetOutboxStats(ctx, nil)
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			switch tt.role {
+			case "":
+			case shared.AdminRoleBootstrap:
+				md.Set(apiKeyMetadataKey, "bootstrap-key")
+			default:
+				md.Set(authorizationMetadataKey, "Bearer "+tokens[tt.role])
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			err := tt.call(ctx)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v (err = %v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
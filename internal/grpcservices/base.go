@@ -0,0 +1,168 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	errdefs "tracking-service/internal/errors"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyMetadataKey        = "x-api-key"
+	authorizationMetadataKey = "authorization"
+	errorInfoDomain          = "tracking-service"
+)
+
+// apiKeyFromContext 從 gRPC metadata 取得 x-api-key
+func apiKeyFromContext(ctx context.Context) string {
+	return metadataValue(ctx, apiKeyMetadataKey)
+}
+
+// clientIPFromContext 與 gin 的 ClientIP 相同，連線來源為可信任的代理時才採用 x-forwarded-for，
+// 由右至左取第一個不是可信任代理的位址
+func clientIPFromContext(ctx context.Context, trustedProxies []netip.Prefix) string {
+	remoteIP := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(remoteIP); err == nil {
+			remoteIP = host
+		}
+	}
+	if !isTrustedProxy(remoteIP, trustedProxies) {
+		return remoteIP
+	}
+
+	forwardedFor := strings.Split(metadataValue(ctx, "x-forwarded-for"), ",")
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwardedFor[i])
+		if _, err := netip.ParseAddr(ip); err != nil {
+			break
+		}
+		if i == 0 || !isTrustedProxy(ip, trustedProxies) {
+			return ip
+		}
+	}
+	return remoteIP
+}
+
+// parseTrustedProxies 接受 IP 或 CIDR，格式錯誤的項目於 NewRouter 啟動時即回報，此處略過
+func parseTrustedProxies(proxies []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+	return prefixes
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// validate 沿用 HTTP 請求結構的 binding 規則檢查輸入
+func validate(in any) error {
+	err := binding.Validator.ValidateStruct(in)
+	if err == nil {
+		return nil
+	}
+
+	var ve validator.ValidationErrors
+	if !errors.As(err, &ve) {
+		return errdefs.ErrorInvalidRequest
+	}
+
+	details := make(map[string]string, len(ve))
+	for _, fe := range ve {
+		field := strings.ToLower(fe.Field())
+		if fe.Tag() == "required" {
+			details[field] = fmt.Sprintf("%s is required", fe.Field())
+		} else {
+			details[field] = fmt.Sprintf("%s failed on the %s rule", fe.Field(), fe.Tag())
+		}
+	}
+	return errdefs.NewValidationError(details)
+}
+
+// toStatusError 將服務層錯誤轉換為對應的 gRPC 狀態碼
+func toStatusError(ctx context.Context, cause error) error {
+	var validationErr *errdefs.ValidationError
+	if errors.As(cause, &validationErr) {
+		st := status.New(codes.InvalidArgument, cause.Error())
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErr.Details))
+		for field, description := range validationErr.Details {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: description,
+			})
+		}
+		if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+			st = withDetails
+		}
+		return st.Err()
+	}
+
+	var code codes.Code
+	switch {
+	case errors.Is(cause, errdefs.ErrorNotFound):
+		code = codes.NotFound
+	case errors.Is(cause, errdefs.ErrorDuplicateKey):
+		code = codes.AlreadyExists
+	case errors.Is(cause, errdefs.ErrorInvalidRequest):
+		code = codes.InvalidArgument
+	case errors.Is(cause, errdefs.ErrorUnauthorized):
+		code = codes.Unauthenticated
+	case errors.Is(cause, errdefs.ErrorForbidden):
+		code = codes.PermissionDenied
+	case errors.Is(cause, errdefs.ErrorRateLimited), errors.Is(cause, errdefs.ErrorQuotaExceeded):
+		code = codes.ResourceExhausted
+	default:
+		log.WithContext(ctx).Errorf("Internal server error: %v", cause)
+		return status.Error(codes.Internal, errdefs.ErrorInternalError.Error())
+	}
+
+	// 帶有錯誤代碼時以 ErrorInfo 的 reason 回傳
+	st := status.New(code, cause.Error())
+	if reason := errdefs.ErrorCode(cause); reason != "" {
+		if withDetails, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorInfoDomain}); err == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
@@ -0,0 +1,39 @@
+package grpcservice
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIPFromContext(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		forwardedFor   string
+		want           string
+	}{
+		{name: "no forwarded for", want: "10.0.0.1"},
+		{name: "spoofed without trusted proxies", forwardedFor: "203.0.113.1", want: "10.0.0.1"},
+		{name: "spoofed from untrusted peer", trustedProxies: []string{"192.168.0.0/16"}, forwardedFor: "203.0.113.1", want: "10.0.0.1"},
+		{name: "trusted proxy", trustedProxies: []string{"10.0.0.1"}, forwardedFor: "203.0.113.1", want: "203.0.113.1"},
+		{name: "rightmost untrusted hop", trustedProxies: []string{"10.0.0.0/8"}, forwardedFor: "198.51.100.7, 203.0.113.1, 10.0.0.2", want: "203.0.113.1"},
+		{name: "invalid forwarded for", trustedProxies: []string{"10.0.0.1"}, forwardedFor: "not-an-ip", want: "10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 12345}})
+			if tt.forwardedFor != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", tt.forwardedFor))
+			}
+
+			if got := clientIPFromContext(ctx, parseTrustedProxies(tt.trustedProxies)); got != tt.want {
+				t.Errorf("clientIPFromContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
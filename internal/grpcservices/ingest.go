@@ -0,0 +1,424 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/netip"
+	"strconv"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	trackingv1 "tracking-service/internal/pb/tracking/v1"
+	service "tracking-service/internal/services"
+	util "tracking-service/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// IngestService 以 gRPC 提供與 /tenant 事件日誌、會話相同的上報功能
+type IngestService struct {
+	trackingv1.UnimplementedIngestServiceServer
+	config             *shared.Config
+	app_service        *service.ApplicationService
+	event_service      *service.EventService
+	rate_limit_service *service.RateLimitService
+	usage_service      *service.UsageService
+	trusted_proxies    []netip.Prefix
+}
+
+func NewIngestService(
+	config *shared.Config,
+	app_service *service.ApplicationService,
+	event_service *service.EventService,
+	rate_limit_service *service.RateLimitService,
+	usage_service *service.UsageService,
+) *IngestService {
+	return &IngestService{
+		config:             config,
+		app_service:        app_service,
+		event_service:      event_service,
+		rate_limit_service: rate_limit_service,
+		usage_service:      usage_service,
+		trusted_proxies:    parseTrustedProxies(config.TrustedProxyList()),
+	}
+}
+
+func (s *IngestService) Register(server *grpc.Server) {
+	trackingv1.RegisterIngestServiceServer(server, s)
+}
+
+func (s *IngestService) TrackEvent(ctx context.Context, req *trackingv1.TrackEventRequest) (*trackingv1.EventLog, error) {
+	application, err := s.authenticate(ctx, shared.APIKeyScopeIngest)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.checkRateLimit(ctx, application, 1); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.checkQuota(ctx, application, model.UsageMetricEventLogs, 1); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateEventLogRequest{
+		EventID:    req.GetEventId(),
+		PlatformID: int(req.GetPlatformId()),
+		SessionID:  req.GetSessionId(),
+		SessionKey: req.GetSessionKey(),
+		Properties: req.GetProperties().AsMap(),
+		MessageID:  req.GetMessageId(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	eventLog, err := s.event_service.CreateEventLog(ctx, &datastructure.EventLog{
+		MessageID:           in.MessageID,
+		TenantID:            application.TenantID,
+		ApplicationID:       application.ID,
+		SessionID:           in.SessionID,
+		SessionKey:          in.SessionKey,
+		EventID:             in.EventID,
+		PlatformID:          in.PlatformID,
+		Properties:          in.Properties,
+		ClientTimestamp:     req.GetClientTimestamp(),
+		UserAgent:           metadataValue(ctx, "user-agent"),
+		IPAddress:           clientIPFromContext(ctx, s.trusted_proxies),
+		IngestionValidation: application.IngestionValidation,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toEventLog(eventLog)
+}
+
+// TrackEvents 持續接收事件日誌，每累積 EventLogBatchMaxSize 筆即以批次送出
+// 結果需保留至串流結束，超過 EventLogStreamMaxSize 筆時以 ResourceExhausted 結束，已送出的批次不會回復
+func (s *IngestService) TrackEvents(stream grpc.ClientStreamingServer[trackingv1.TrackEventRequest, trackingv1.TrackEventsResponse]) error {
+	ctx := stream.Context()
+	application, err := s.authenticate(ctx, shared.APIKeyScopeIngest)
+	if err != nil {
+		return toStatusError(ctx, err)
+	}
+	if err := s.checkQuota(ctx, application, model.UsageMetricEventLogs, 1); err != nil {
+		return toStatusError(ctx, err)
+	}
+
+	userAgent, clientIP := metadataValue(ctx, "user-agent"), clientIPFromContext(ctx, s.trusted_proxies)
+	resp := &trackingv1.TrackEventsResponse{}
+	pending := make([]*datastructure.EventLog, 0, s.config.EventLogBatchMaxSize)
+	positions := make([]int, 0, s.config.EventLogBatchMaxSize)
+
+	// reject 將整批標記為失敗，超過配額的拒絕數量已由 checkQuota 計入
+	reject := func(err error) {
+		for _, i := range positions {
+			setResultError(resp.Results[i], err)
+		}
+		pending = pending[:0]
+		positions = positions[:0]
+	}
+
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		// 每批依事件數量取得令牌並檢查配額，長時間的串流同樣受限制
+		if err := s.checkRateLimit(ctx, application, len(pending)); err != nil {
+			reject(err)
+			return nil
+		}
+		if err := s.checkQuota(ctx, application, model.UsageMetricEventLogs, len(pending)); err != nil {
+			reject(err)
+			return nil
+		}
+		eventLogs, errs, err := s.event_service.CreateEventLogBatch(ctx, application.ID, pending)
+		if err != nil {
+			return err
+		}
+		for j, i := range positions {
+			result := resp.Results[i]
+			if errs[j] != nil {
+				setResultError(result, errs[j])
+				continue
+			}
+			eventLog, err := toEventLog(eventLogs[j])
+			if err != nil {
+				return err
+			}
+			result.Success = true
+			result.EventLog = eventLog
+		}
+		pending = pending[:0]
+		positions = positions[:0]
+		return nil
+	}
+
+	for index := 0; ; index++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if index >= s.config.EventLogStreamMaxSize {
+			return status.Errorf(codes.ResourceExhausted, "stream must contain at most %d events", s.config.EventLogStreamMaxSize)
+		}
+
+		result := &trackingv1.TrackEventResult{Index: int32(index)}
+		resp.Results = append(resp.Results, result)
+
+		item := datastructure.EventLogBatchItemRequest{
+			EventID:         req.GetEventId(),
+			SessionID:       req.GetSessionId(),
+			SessionKey:      req.GetSessionKey(),
+			PlatformID:      int(req.GetPlatformId()),
+			Properties:      req.GetProperties().AsMap(),
+			ClientTimestamp: req.GetClientTimestamp(),
+			MessageID:       req.GetMessageId(),
+		}
+		if err := validate(&item); err != nil {
+			// 未通過驗證的資料不會進入服務層，於此計入拒絕數量
+			s.usage_service.Record(application.TenantID, application.ID, model.UsageMetricEventLogs, 0, 1)
+			setResultError(result, err)
+			continue
+		}
+
+		pending = append(pending, &datastructure.EventLog{
+			TenantID:            application.TenantID,
+			ApplicationID:       application.ID,
+			SessionID:           item.SessionID,
+			SessionKey:          item.SessionKey,
+			EventID:             item.EventID,
+			PlatformID:          item.PlatformID,
+			Properties:          item.Properties,
+			ClientTimestamp:     item.ClientTimestamp,
+			MessageID:           item.MessageID,
+			UserAgent:           userAgent,
+			IPAddress:           clientIP,
+			IngestionValidation: application.IngestionValidation,
+		})
+		positions = append(positions, index)
+		if len(pending) >= s.config.EventLogBatchMaxSize {
+			if err := flush(); err != nil {
+				return toStatusError(ctx, err)
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return toStatusError(ctx, err)
+	}
+
+	for _, result := range resp.Results {
+		if result.Success {
+			resp.Accepted++
+		} else {
+			resp.Rejected++
+		}
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (s *IngestService) StartSession(ctx context.Context, req *trackingv1.StartSessionRequest) (*trackingv1.Session, error) {
+	application, err := s.authenticate(ctx, shared.APIKeyScopeSessions)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.checkRateLimit(ctx, application, 1); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.checkQuota(ctx, application, model.UsageMetricSessions, 1); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.CreateSessionRequest{
+		PlatformID: int(req.GetPlatformId()),
+		SessionKey: req.GetSessionKey(),
+		UserID:     req.UserId,
+		UserAgent:  req.UserAgent,
+		IPAddress:  req.IpAddress,
+		StartedAt:  req.GetStartedAt(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	session, err := s.app_service.CreateSession(ctx, &datastructure.Session{
+		ApplicationID: application.ID,
+		PlatformID:    in.PlatformID,
+		SessionKey:    in.SessionKey,
+		UserID:        in.UserID,
+		UserAgent:     in.UserAgent,
+		IPAddress:     in.IPAddress,
+		StartedAt:     in.StartedAt,
+	})
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toSession(session), nil
+}
+
+func (s *IngestService) EndSession(ctx context.Context, req *trackingv1.EndSessionRequest) (*trackingv1.Session, error) {
+	application, err := s.authenticate(ctx, shared.APIKeyScopeSessions)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if err := s.checkRateLimit(ctx, application, 1); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	in := datastructure.UpdateSessionRequest{
+		EndedAt: req.GetEndedAt(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+	if req.GetSessionId() == "" {
+		return nil, toStatusError(ctx, errdefs.NewValidationError(map[string]string{
+			"session_id": "session_id is required",
+		}))
+	}
+	if in.EndedAt == "" {
+		in.EndedAt = time.Now().UTC().Format(time.DateTime)
+	}
+
+	err = s.app_service.UpdateSessionByApplicationIDAndID(ctx, application.ID, req.GetSessionId(), &in)
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	session, err := s.app_service.GetSessionByApplicationIDAndID(ctx, application.ID, req.GetSessionId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toSession(session), nil
+}
+
+// authenticate 以 metadata 中的 x-api-key 驗證應用程式、權限範圍與來源，等同 TenantAuthMiddleware 與 RequireScope
+// 速率限制依寫入數量由各方法呼叫 checkRateLimit
+func (s *IngestService) authenticate(ctx context.Context, scope string) (*model.Application, error) {
+	apiKey := apiKeyFromContext(ctx)
+	if apiKey == "" {
+		return nil, errdefs.ErrorUnauthorized
+	}
+
+	application, applicationApiKey, err := s.app_service.ValidateAPIKey(ctx, apiKey)
+	if err != nil {
+		return nil, errdefs.ErrorUnauthorized
+	}
+	if !applicationApiKey.HasScope(scope) {
+		return nil, errdefs.ErrorForbidden
+	}
+	// 經由 gRPC-Web 代理時，瀏覽器的 Origin 與 Referer 會轉為 metadata
+	if err := s.app_service.AuthorizeOrigin(application, applicationApiKey, metadataValue(ctx, "origin"), metadataValue(ctx, "referer")); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// checkRateLimit 與 RateLimitMiddleware 相同，n 為寫入的數量，限制資訊以 header metadata 回傳
+func (s *IngestService) checkRateLimit(ctx context.Context, application *model.Application, n int) error {
+	decision, err := s.rate_limit_service.Allow(ctx, application, n)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("Failed to check rate limit for application %s", application.ID)
+		return nil
+	}
+
+	if decision.Limit > 0 {
+		md := metadata.Pairs(
+			"x-ratelimit-limit", strconv.Itoa(decision.Limit),
+			"x-ratelimit-remaining", strconv.Itoa(decision.Remaining),
+			"x-ratelimit-reset", strconv.Itoa(int(math.Ceil(decision.Reset.Seconds()))),
+		)
+		if !decision.Allowed && !decision.ExceedsBurst {
+			md.Set("retry-after", strconv.Itoa(max(int(math.Ceil(decision.RetryAfter.Seconds())), 1)))
+		}
+		_ = grpc.SetHeader(ctx, md)
+	}
+
+	return decision.Err()
+}
+
+// checkQuota 與 QuotaMiddleware 相同，n 為寫入的數量，配額資訊以 header metadata 回傳
+func (s *IngestService) checkQuota(ctx context.Context, application *model.Application, metric string, n int) error {
+	decision, err := s.usage_service.CheckQuota(ctx, application.TenantID, metric, n)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("Failed to check quota for tenant %s", application.TenantID)
+		return nil
+	}
+
+	if decision.Limit > 0 {
+		md := metadata.Pairs(
+			"x-quota-limit", strconv.FormatInt(decision.Limit, 10),
+			"x-quota-remaining", strconv.FormatInt(max(decision.Limit-decision.Used, 0), 10),
+		)
+		if decision.Warning {
+			md.Set("x-quota-warning", fmt.Sprintf("%s usage has reached %d%% of the monthly quota", metric, decision.Used*100/decision.Limit))
+		}
+		_ = grpc.SetHeader(ctx, md)
+	}
+
+	if !decision.Allowed {
+		s.usage_service.Record(application.TenantID, application.ID, metric, 0, n)
+		return errdefs.ErrorQuotaExceeded
+	}
+	return nil
+}
+
+func setResultError(result *trackingv1.TrackEventResult, err error) {
+	result.Msg = err.Error()
+	result.Code = errdefs.ErrorCode(err)
+	var validationErr *errdefs.ValidationError
+	if errors.As(err, &validationErr) {
+		result.Details = validationErr.Details
+	}
+}
+
+func toEventLog(eventLog *model.EventLog) (*trackingv1.EventLog, error) {
+	properties, err := structpb.NewStruct(eventLog.Properties)
+	if err != nil {
+		return nil, errdefs.ErrorInternalError
+	}
+
+	return &trackingv1.EventLog{
+		Id:              eventLog.ID,
+		MessageId:       eventLog.MessageID,
+		ApplicationId:   eventLog.ApplicationID,
+		SessionId:       eventLog.SessionID,
+		EventId:         eventLog.EventID,
+		PlatformId:      int32(eventLog.PlatformID),
+		Properties:      properties,
+		ClientTimestamp: util.ConvertTimeToTimeStamp(eventLog.ClientTimestamp),
+		CreatedAt:       util.ConvertTimeToTimeStamp(&eventLog.CreatedAt),
+		Violations:      eventLog.Violations,
+	}, nil
+}
+
+func toSession(session *model.Session) *trackingv1.Session {
+	return &trackingv1.Session{
+		Id:            session.ID,
+		ApplicationId: session.ApplicationID,
+		PlatformId:    int32(session.PlatformID),
+		SessionKey:    session.SessionKey,
+		UserId:        session.UserID,
+		UserAgent:     session.UserAgent,
+		IpAddress:     session.IPAddress,
+		StartedAt:     util.ConvertTimeToTimeStamp(&session.StartedAt),
+		EndedAt:       util.ConvertTimeToTimeStamp(session.EndedAt),
+		CreatedAt:     util.ConvertTimeToTimeStamp(&session.CreatedAt),
+		UpdatedAt:     util.ConvertTimeToTimeStamp(&session.UpdatedAt),
+	}
+}
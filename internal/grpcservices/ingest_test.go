@@ -0,0 +1,121 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	component "tracking-service/internal/components"
+	model "tracking-service/internal/models"
+	trackingv1 "tracking-service/internal/pb/tracking/v1"
+	repository "tracking-service/internal/repositories"
+	service "tracking-service/internal/services"
+	util "tracking-service/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testAPIKey = "test-api-key"
+
+type fakeApplicationRepository struct {
+	repository.ApplicationRepository
+}
+
+func (r *fakeApplicationRepository) GetApplicationByAPIKeyHash(_ context.Context, keyHash string) (*model.Application, *model.ApplicationApiKey, error) {
+	if keyHash != util.HashAPIKey(testAPIKey) {
+		return nil, nil, errors.New("record not found")
+	}
+	return &model.Application{ID: "app-1", TenantID: "tenant-1"},
+		&model.ApplicationApiKey{ID: "key-1", Type: shared.APIKeyTypeSecret, Scopes: model.StringArray{shared.APIKeyScopeIngest}},
+		nil
+}
+
+func (r *fakeApplicationRepository) UpdateApplicationAPIKeyLastUsedAt(context.Context, string, time.Time) error {
+	return nil
+}
+
+type fakeTenantRepository struct {
+	repository.TenantRepository
+}
+
+func (r *fakeTenantRepository) GetTenantByID(_ context.Context, id string) (*model.Tenant, error) {
+	return &model.Tenant{ID: id}, nil
+}
+
+type fakeUsageRepository struct {
+	repository.UsageRepository
+}
+
+func (r *fakeUsageRepository) SumUsageByTenant(context.Context, string, time.Time, time.Time) ([]*repository.UsageTotal, error) {
+	return nil, nil
+}
+
+type fakeTrackEventsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*trackingv1.TrackEventRequest
+	resp     *trackingv1.TrackEventsResponse
+}
+
+func (s *fakeTrackEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeTrackEventsStream) Recv() (*trackingv1.TrackEventRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeTrackEventsStream) SendAndClose(resp *trackingv1.TrackEventsResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestIngestServiceTrackEventsMaxSize(t *testing.T) {
+	component.NewValidator()
+
+	tests := []struct {
+		name     string
+		items    int
+		wantCode codes.Code
+	}{
+		{name: "at limit", items: 3, wantCode: codes.OK},
+		{name: "over limit", items: 4, wantCode: codes.ResourceExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &shared.Config{EventLogBatchMaxSize: 2, EventLogStreamMaxSize: 3, ApiKeyCacheSize: 10}
+			tenants := &fakeTenantRepository{}
+			usage := service.NewUsageService(config, &fakeUsageRepository{}, tenants)
+			apps := service.NewApplicationService(config, nil, &fakeApplicationRepository{}, tenants, nil, nil, usage, nil, nil)
+			s := NewIngestService(config, apps, nil, nil, usage)
+
+			// 未通過驗證的項目不會送往服務層，僅計入結果
+			stream := &fakeTrackEventsStream{
+				ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyMetadataKey, testAPIKey)),
+				requests: make([]*trackingv1.TrackEventRequest, tt.items),
+			}
+			for i := range stream.requests {
+				stream.requests[i] = &trackingv1.TrackEventRequest{}
+			}
+
+			err := s.TrackEvents(stream)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err = %v)", got, tt.wantCode, err)
+			}
+			if tt.wantCode == codes.OK && len(stream.resp.GetResults()) != tt.items {
+				t.Errorf("results = %d, want %d", len(stream.resp.GetResults()), tt.items)
+			}
+		})
+	}
+}
@@ -1,8 +1,14 @@
 package handler
 
 import (
+	"errors"
+	"io"
 	"strconv"
+	"time"
+	shared "tracking-service/internal"
 	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
 	service "tracking-service/internal/services"
 	util "tracking-service/internal/utils"
 
@@ -11,23 +17,37 @@ import (
 
 type AdminHandler struct {
 	BaseHandler
-	tenant_service   *service.TenantService
-	platform_service *service.PlatformService
-	app_service      *service.ApplicationService
-	event_service    *service.EventService
+	tenant_service    *service.TenantService
+	platform_service  *service.PlatformService
+	app_service       *service.ApplicationService
+	event_service     *service.EventService
+	outbox_service    *service.OutboxService
+	usage_service     *service.UsageService
+	audit_service     *service.AuditService
+	analytics_service *service.AnalyticsService
 }
 
 func NewAdminHandler(
+	config *shared.Config,
 	tenant_service *service.TenantService,
 	platform_service *service.PlatformService,
 	app_service *service.ApplicationService,
 	event_service *service.EventService,
+	outbox_service *service.OutboxService,
+	usage_service *service.UsageService,
+	audit_service *service.AuditService,
+	analytics_service *service.AnalyticsService,
 ) *AdminHandler {
 	return &AdminHandler{
-		tenant_service:   tenant_service,
-		platform_service: platform_service,
-		app_service:      app_service,
-		event_service:    event_service,
+		BaseHandler:       NewBaseHandler(config),
+		tenant_service:    tenant_service,
+		platform_service:  platform_service,
+		app_service:       app_service,
+		event_service:     event_service,
+		outbox_service:    outbox_service,
+		usage_service:     usage_service,
+		audit_service:     audit_service,
+		analytics_service: analytics_service,
 	}
 }
 
@@ -45,6 +65,7 @@ func NewAdminHandler(
 // @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/tenants [post]
 func (h *AdminHandler) CreateTenant(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -57,6 +78,8 @@ func (h *AdminHandler) CreateTenant(c *gin.Context) {
 	reqTenant := &datastructure.Tenant{
 		Name:        req.Name,
 		Description: req.Description,
+		RateLimit:   req.RateLimit,
+		Quota:       req.Quota,
 	}
 
 	tenant, err := h.tenant_service.CreateTenant(ctx, reqTenant)
@@ -69,6 +92,8 @@ func (h *AdminHandler) CreateTenant(c *gin.Context) {
 		ID:          tenant.ID,
 		Name:        tenant.Name,
 		Description: tenant.Description,
+		RateLimit:   toRateLimitResponse(tenant.RateLimit),
+		Quota:       toQuotaResponse(tenant.Quota),
 		CreatedAt:   util.ConvertTimeToTimeStamp(&tenant.CreatedAt),
 		UpdatedAt:   util.ConvertTimeToTimeStamp(&tenant.UpdatedAt),
 		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(tenant.DeletedAt),
@@ -90,6 +115,7 @@ func (h *AdminHandler) CreateTenant(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/tenants/{tenant_id} [get]
 func (h *AdminHandler) GetTenant(c *gin.Context) {
 	tenantID := c.Param("tenant_id")
@@ -104,6 +130,8 @@ func (h *AdminHandler) GetTenant(c *gin.Context) {
 		ID:          tenant.ID,
 		Name:        tenant.Name,
 		Description: tenant.Description,
+		RateLimit:   toRateLimitResponse(tenant.RateLimit),
+		Quota:       toQuotaResponse(tenant.Quota),
 		CreatedAt:   util.ConvertTimeToTimeStamp(&tenant.CreatedAt),
 		UpdatedAt:   util.ConvertTimeToTimeStamp(&tenant.UpdatedAt),
 		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(tenant.DeletedAt),
@@ -126,6 +154,7 @@ func (h *AdminHandler) GetTenant(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/tenants/{tenant_id} [put]
 func (h *AdminHandler) UpdateTenant(c *gin.Context) {
 	tenantID := c.Param("tenant_id")
@@ -139,6 +168,8 @@ func (h *AdminHandler) UpdateTenant(c *gin.Context) {
 	reqTenant := &datastructure.Tenant{
 		Name:        req.Name,
 		Description: req.Description,
+		RateLimit:   req.RateLimit,
+		Quota:       req.Quota,
 	}
 
 	if err := h.tenant_service.UpdateTenant(c.Request.Context(), tenantID, reqTenant); err != nil {
@@ -150,38 +181,89 @@ func (h *AdminHandler) UpdateTenant(c *gin.Context) {
 }
 
 // GetTenants godoc
-// @Summary      取得所有租戶
-// @Description  取得所有租戶
+// @Summary      取得租戶列表
+// @Description  分頁取得租戶，限定租戶的使用者僅回傳所屬租戶
 // @Tags         Admin/Tenant
 // @Produce      json
-// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Tenant}  "成功回應，包含所有租戶陣列"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Tenant}  "成功回應，包含租戶陣列，有下一頁時帶入 next_cursor"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/tenants [get]
 func (h *AdminHandler) GetTenants(c *gin.Context) {
-	tenants, err := h.tenant_service.GetTenants(c.Request.Context())
+	var req datastructure.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	tenants, nextCursor, err := h.tenant_service.GetTenants(c.Request.Context(), adminPrincipal(c).TenantID, &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
 	}
 
-	respTenants := make([]*datastructure.Tenant, 0)
+	respTenants := make([]*datastructure.Tenant, 0, len(tenants))
 	for _, tenant := range tenants {
 		respTenants = append(respTenants, &datastructure.Tenant{
 			ID:          tenant.ID,
 			Name:        tenant.Name,
 			Description: tenant.Description,
+			RateLimit:   toRateLimitResponse(tenant.RateLimit),
+			Quota:       toQuotaResponse(tenant.Quota),
 			CreatedAt:   util.ConvertTimeToTimeStamp(&tenant.CreatedAt),
 			UpdatedAt:   util.ConvertTimeToTimeStamp(&tenant.UpdatedAt),
 			DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(tenant.DeletedAt),
 		})
 	}
 
-	h.Success(c, respTenants)
+	h.SuccessWithCursor(c, respTenants, nextCursor)
+}
+
+// GetTenantUsage godoc
+// @Summary      取得指定租戶用量
+// @Description  取得指定租戶各應用程式每日的事件日誌與工作階段接受、拒絕數量，以及當月配額用量；用量最多延遲一個寫入間隔
+// @Tags         Admin/Tenant
+// @Produce      json
+// @Param        tenant_id       path   string  true   "租戶 ID"
+// @Param        from            query  string  false  "起始日期 (UTC)，預設為當月一日"
+// @Param        to              query  string  false  "結束日期 (UTC)，預設為今日"
+// @Param        application_id  query  string  false  "應用程式 ID"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.UsageResponse}  "成功回應，包含租戶用量"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/tenants/{tenant_id}/usage [get]
+func (h *AdminHandler) GetTenantUsage(c *gin.Context) {
+	tenantID := c.Param("tenant_id")
+
+	var req datastructure.GetUsageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	report, err := h.usage_service.GetUsage(c.Request.Context(), tenantID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toUsageResponse(tenantID, report))
 }
 
 // CreatePlatform godoc
@@ -198,6 +280,7 @@ func (h *AdminHandler) GetTenants(c *gin.Context) {
 // @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/platforms [post]
 func (h *AdminHandler) CreatePlatform(c *gin.Context) {
 	var req datastructure.CreatePlatformRequest
@@ -240,6 +323,7 @@ func (h *AdminHandler) CreatePlatform(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/platforms/{platform_id} [get]
 func (h *AdminHandler) GetPlatform(c *gin.Context) {
 	// 字串轉整數
@@ -267,26 +351,39 @@ func (h *AdminHandler) GetPlatform(c *gin.Context) {
 }
 
 // GetPlatforms godoc
-// @Summary      取得所有平台
-// @Description  取得所有平台
+// @Summary      取得平台列表
+// @Description  分頁取得平台
 // @Tags         Admin/Platform
 // @Produce      json
-// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Platform}  "成功回應，包含所有平台陣列"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Platform}  "成功回應，包含平台陣列，有下一頁時帶入 next_cursor"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/platforms [get]
 func (h *AdminHandler) GetPlatforms(c *gin.Context) {
-	platforms, err := h.platform_service.GetPlatforms(c.Request.Context())
+	var req datastructure.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	platforms, nextCursor, err := h.platform_service.GetPlatforms(c.Request.Context(), &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
 	}
 
-	respPlatforms := make([]*datastructure.Platform, 0)
+	respPlatforms := make([]*datastructure.Platform, 0, len(platforms))
 	for _, platform := range platforms {
 		respPlatforms = append(respPlatforms, &datastructure.Platform{
 			ID:        platform.ID,
@@ -297,7 +394,7 @@ func (h *AdminHandler) GetPlatforms(c *gin.Context) {
 		})
 	}
 
-	h.Success(c, respPlatforms)
+	h.SuccessWithCursor(c, respPlatforms, nextCursor)
 }
 
 // CreateApp godoc
@@ -314,6 +411,7 @@ func (h *AdminHandler) GetPlatforms(c *gin.Context) {
 // @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps [post]
 func (h *AdminHandler) CreateApp(c *gin.Context) {
 	var req datastructure.CreateApplicationRequest
@@ -322,9 +420,19 @@ func (h *AdminHandler) CreateApp(c *gin.Context) {
 		return
 	}
 
+	if !adminPrincipal(c).CanAccessTenant(req.TenantID) {
+		h.ErrorResponse(c, errdefs.ErrorForbidden)
+		return
+	}
+
 	reqApp := &datastructure.Application{
-		Name:        req.Name,
-		Description: req.Description,
+		TenantID:              req.TenantID,
+		Name:                  req.Name,
+		Description:           req.Description,
+		AllowedOrigins:        req.AllowedOrigins,
+		RateLimit:             req.RateLimit,
+		SessionTimeoutSeconds: req.SessionTimeoutSeconds,
+		IngestionValidation:   req.IngestionValidation,
 	}
 
 	app, err := h.app_service.CreateApplication(c.Request.Context(), reqApp)
@@ -333,14 +441,25 @@ func (h *AdminHandler) CreateApp(c *gin.Context) {
 		return
 	}
 
+	// 完整密鑰只在建立時回傳一次
+	respAPIKeys := make([]datastructure.ApplicationAPIKey, 0, len(app.ApiKeys))
+	for i := range app.ApiKeys {
+		respAPIKeys = append(respAPIKeys, toApplicationAPIKeyResponse(&app.ApiKeys[i]))
+	}
+
 	respApp := datastructure.Application{
-		ID:          app.ID,
-		TenantID:    app.TenantID,
-		Name:        app.Name,
-		Description: app.Description,
-		CreatedAt:   util.ConvertTimeToTimeStamp(&app.CreatedAt),
-		UpdatedAt:   util.ConvertTimeToTimeStamp(&app.UpdatedAt),
-		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
+		ID:                    app.ID,
+		TenantID:              app.TenantID,
+		Name:                  app.Name,
+		Description:           app.Description,
+		AllowedOrigins:        app.AllowedOrigins,
+		RateLimit:             toRateLimitResponse(app.RateLimit),
+		SessionTimeoutSeconds: &app.SessionTimeoutSeconds,
+		IngestionValidation:   &app.IngestionValidation,
+		APIKeys:               respAPIKeys,
+		CreatedAt:             util.ConvertTimeToTimeStamp(&app.CreatedAt),
+		UpdatedAt:             util.ConvertTimeToTimeStamp(&app.UpdatedAt),
+		DeletedAt:             util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
 	}
 
 	h.Success(c, respApp)
@@ -359,6 +478,7 @@ func (h *AdminHandler) CreateApp(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps/{app_id} [get]
 func (h *AdminHandler) GetApp(c *gin.Context) {
 	appID := c.Param("app_id")
@@ -369,14 +489,24 @@ func (h *AdminHandler) GetApp(c *gin.Context) {
 		return
 	}
 
+	respAPIKeys := make([]datastructure.ApplicationAPIKey, 0, len(app.ApiKeys))
+	for i := range app.ApiKeys {
+		respAPIKeys = append(respAPIKeys, toApplicationAPIKeyResponse(&app.ApiKeys[i]))
+	}
+
 	respApp := datastructure.Application{
-		ID:          app.ID,
-		TenantID:    app.TenantID,
-		Name:        app.Name,
-		Description: app.Description,
-		CreatedAt:   util.ConvertTimeToTimeStamp(&app.CreatedAt),
-		UpdatedAt:   util.ConvertTimeToTimeStamp(&app.UpdatedAt),
-		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
+		ID:                    app.ID,
+		TenantID:              app.TenantID,
+		Name:                  app.Name,
+		Description:           app.Description,
+		AllowedOrigins:        app.AllowedOrigins,
+		RateLimit:             toRateLimitResponse(app.RateLimit),
+		SessionTimeoutSeconds: &app.SessionTimeoutSeconds,
+		IngestionValidation:   &app.IngestionValidation,
+		APIKeys:               respAPIKeys,
+		CreatedAt:             util.ConvertTimeToTimeStamp(&app.CreatedAt),
+		UpdatedAt:             util.ConvertTimeToTimeStamp(&app.UpdatedAt),
+		DeletedAt:             util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
 	}
 
 	h.Success(c, respApp)
@@ -396,6 +526,7 @@ func (h *AdminHandler) GetApp(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps/{app_id} [put]
 func (h *AdminHandler) UpdateApp(c *gin.Context) {
 	var req datastructure.UpdateApplicationRequest
@@ -404,12 +535,22 @@ func (h *AdminHandler) UpdateApp(c *gin.Context) {
 		return
 	}
 
+	// 限定租戶的使用者不可將應用程式移至其他租戶
+	if !adminPrincipal(c).CanAccessTenant(req.TenantID) {
+		h.ErrorResponse(c, errdefs.ErrorForbidden)
+		return
+	}
+
 	appID := c.Param("app_id")
 	reqApp := &datastructure.Application{
-		ID:          appID,
-		TenantID:    req.TenantID,
-		Name:        req.Name,
-		Description: req.Description,
+		ID:                    appID,
+		TenantID:              req.TenantID,
+		Name:                  req.Name,
+		Description:           req.Description,
+		AllowedOrigins:        req.AllowedOrigins,
+		RateLimit:             req.RateLimit,
+		SessionTimeoutSeconds: req.SessionTimeoutSeconds,
+		IngestionValidation:   req.IngestionValidation,
 	}
 
 	err := h.app_service.UpdateApplicationByID(c.Request.Context(), appID, reqApp)
@@ -434,6 +575,7 @@ func (h *AdminHandler) UpdateApp(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps/{app_id} [delete]
 func (h *AdminHandler) DeleteApp(c *gin.Context) {
 	appID := c.Param("app_id")
@@ -448,74 +590,159 @@ func (h *AdminHandler) DeleteApp(c *gin.Context) {
 }
 
 // GetApps godoc
-// @Summary      取得所有應用程式
-// @Description  取得所有應用程式
+// @Summary      取得應用程式列表
+// @Description  分頁取得應用程式，限定租戶的使用者僅回傳所屬租戶的應用程式
 // @Tags         Admin/Application
 // @Produce      json
-// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Application}  "成功回應，包含所有應用程式陣列"
+// @Param        tenant_id     query  string  false  "租戶 ID"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Application}  "成功回應，包含應用程式陣列，有下一頁時帶入 next_cursor"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps [get]
 func (h *AdminHandler) GetApps(c *gin.Context) {
-	apps, err := h.app_service.GetApplications(c.Request.Context())
+	var req datastructure.GetApplicationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	apps, nextCursor, err := h.app_service.GetApplications(c.Request.Context(), adminPrincipal(c).TenantID, &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
 	}
 
-	respApps := make([]*datastructure.Application, 0)
+	respApps := make([]*datastructure.Application, 0, len(apps))
 	for _, app := range apps {
 		respApps = append(respApps, &datastructure.Application{
-			ID:          app.ID,
-			TenantID:    app.TenantID,
-			Name:        app.Name,
-			Description: app.Description,
-			CreatedAt:   util.ConvertTimeToTimeStamp(&app.CreatedAt),
-			UpdatedAt:   util.ConvertTimeToTimeStamp(&app.UpdatedAt),
-			DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
+			ID:                    app.ID,
+			TenantID:              app.TenantID,
+			Name:                  app.Name,
+			Description:           app.Description,
+			AllowedOrigins:        app.AllowedOrigins,
+			RateLimit:             toRateLimitResponse(app.RateLimit),
+			SessionTimeoutSeconds: &app.SessionTimeoutSeconds,
+			IngestionValidation:   &app.IngestionValidation,
+			CreatedAt:             util.ConvertTimeToTimeStamp(&app.CreatedAt),
+			UpdatedAt:             util.ConvertTimeToTimeStamp(&app.UpdatedAt),
+			DeletedAt:             util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
 		})
 	}
 
-	h.Success(c, respApps)
+	h.SuccessWithCursor(c, respApps, nextCursor)
 }
 
 // CreateAppAPIKey godoc
 // @Summary      建立應用程式 API 密鑰
-// @Description  建立應用程式 API 密鑰
+// @Description  建立應用程式 API 密鑰，可指定權限範圍與到期時間
 // @Tags         Admin/Application
+// @Accept       json
 // @Produce      json
 // @Param        app_id  path      string  true  "應用程式 ID"
-// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.ApplicationAPIKey}  "成功回應，包含新應用程式 API 密鑰"
+// @Param        request body      datastructure.CreateApplicationAPIKeyRequest false "權限範圍與到期時間，未指定時授予全部權限並套用預設有效期"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.ApplicationAPIKey}  "成功回應，包含新應用程式 API 密鑰，完整密鑰僅回傳一次"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/apps/{app_id}/api_keys [post]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/api-keys [post]
 func (h *AdminHandler) CreateAppAPIKey(c *gin.Context) {
 	appID := c.Param("app_id")
 
-	apiKey, err := h.app_service.CreateApplicationAPIKey(c.Request.Context(), appID)
+	// 未帶 body 時沿用預設值
+	var req datastructure.CreateApplicationAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	apiKey, err := h.app_service.CreateApplicationAPIKey(c.Request.Context(), appID, &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
 	}
 
-	respAppAPIKey := datastructure.ApplicationAPIKey{
-		ID:            apiKey.ID,
-		ApplicationID: appID,
-		APIKey:        apiKey.APIKey,
-		CreatedAt:     util.ConvertTimeToTimeStamp(&apiKey.CreatedAt),
-		UpdatedAt:     util.ConvertTimeToTimeStamp(&apiKey.UpdatedAt),
-		DeletedAt:     util.ConvertGormDeletedAtToTimeStamp(apiKey.DeletedAt),
+	h.Success(c, toApplicationAPIKeyResponse(apiKey))
+}
+
+// RotateAppAPIKey godoc
+// @Summary      輪替應用程式 API 密鑰
+// @Description  以相同權限範圍建立新密鑰，舊密鑰在寬限期內仍可使用
+// @Tags         Admin/Application
+// @Accept       json
+// @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
+// @Param        api_key_id  path      string  true  "應用程式 API 密鑰 ID"
+// @Param        request body      datastructure.RotateApplicationAPIKeyRequest false "新密鑰到期時間，未指定時套用預設有效期"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.ApplicationAPIKey}  "成功回應，包含新應用程式 API 密鑰，完整密鑰僅回傳一次"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/api-keys/{api_key_id}/rotate [post]
+func (h *AdminHandler) RotateAppAPIKey(c *gin.Context) {
+	appID := c.Param("app_id")
+	apiKeyID := c.Param("api_key_id")
+
+	var req datastructure.RotateApplicationAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	apiKey, err := h.app_service.RotateApplicationAPIKey(c.Request.Context(), appID, apiKeyID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
 	}
 
-	h.Success(c, respAppAPIKey)
+	h.Success(c, toApplicationAPIKeyResponse(apiKey))
+}
+
+// RevokeAppAPIKey godoc
+// @Summary      撤銷應用程式 API 密鑰
+// @Description  立即停用應用程式 API 密鑰，保留紀錄
+// @Tags         Admin/Application
+// @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
+// @Param        api_key_id  path      string  true  "應用程式 API 密鑰 ID"
+// @Success      204     "成功，無內容回應"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/api-keys/{api_key_id}/revoke [post]
+func (h *AdminHandler) RevokeAppAPIKey(c *gin.Context) {
+	appID := c.Param("app_id")
+	apiKeyID := c.Param("api_key_id")
+
+	err := h.app_service.RevokeApplicationAPIKey(c.Request.Context(), appID, apiKeyID)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.SuccessWithoutContent(c)
 }
 
 // DeleteAppAPIKey godoc
@@ -532,6 +759,7 @@ func (h *AdminHandler) CreateAppAPIKey(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps/{app_id}/api-keys/{api_key_id} [delete]
 func (h *AdminHandler) DeleteAppAPIKey(c *gin.Context) {
 	appID := c.Param("app_id")
@@ -552,6 +780,7 @@ func (h *AdminHandler) DeleteAppAPIKey(c *gin.Context) {
 // @Tags         Admin/Event
 // @Accept       json
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        request  body  datastructure.Event  true  "新增事件資料"
 // @Success      200      {object}  datastructure.BaseResponse{data=datastructure.Event}  "成功回應，包含新事件資料"
 // @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
@@ -560,7 +789,8 @@ func (h *AdminHandler) DeleteAppAPIKey(c *gin.Context) {
 // @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events [post]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events [post]
 func (h *AdminHandler) CreateEvent(c *gin.Context) {
 	var req datastructure.CreateEventRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -569,7 +799,7 @@ func (h *AdminHandler) CreateEvent(c *gin.Context) {
 	}
 
 	reqEvent := &datastructure.Event{
-		ApplicationID: req.ApplicationID,
+		ApplicationID: c.Param("app_id"),
 		PlatformID:    req.PlatformID,
 		Name:          req.Name,
 		Description:   req.Description,
@@ -602,6 +832,7 @@ func (h *AdminHandler) CreateEvent(c *gin.Context) {
 // @Description  取得指定事件詳細資料
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
 // @Success      200     {object}  datastructure.BaseResponse{data=datastructure.Event}  "成功回應，包含應用程式詳細資料"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
@@ -610,11 +841,13 @@ func (h *AdminHandler) CreateEvent(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events/{event_id} [get]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events/{event_id} [get]
 func (h *AdminHandler) GetEvent(c *gin.Context) {
+	appID := c.Param("app_id")
 	eventID := c.Param("event_id")
 
-	event, err := h.event_service.GetEventByID(c.Request.Context(), eventID)
+	event, err := h.event_service.GetEventByTenant(c.Request.Context(), appID, eventID)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -640,6 +873,7 @@ func (h *AdminHandler) GetEvent(c *gin.Context) {
 // @Description  更新指定事件
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
 // @Param        request  body  datastructure.Event  true  "更新事件資料"
 // @Success      204     "成功，無內容回應"
@@ -649,7 +883,8 @@ func (h *AdminHandler) GetEvent(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events/{event_id} [put]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events/{event_id} [put]
 func (h *AdminHandler) UpdateEvent(c *gin.Context) {
 	var req datastructure.UpdateEventRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -668,7 +903,7 @@ func (h *AdminHandler) UpdateEvent(c *gin.Context) {
 		IsActive:      req.IsActive,
 	}
 
-	err := h.event_service.UpdateEventByID(c.Request.Context(), eventID, reqEvent)
+	err := h.event_service.UpdateEventByTenant(c.Request.Context(), appID, reqEvent)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -682,6 +917,7 @@ func (h *AdminHandler) UpdateEvent(c *gin.Context) {
 // @Description  刪除指定事件
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
 // @Success      204     "成功，無內容回應"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
@@ -690,11 +926,13 @@ func (h *AdminHandler) UpdateEvent(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events/{event_id} [delete]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events/{event_id} [delete]
 func (h *AdminHandler) DeleteEvent(c *gin.Context) {
+	appID := c.Param("app_id")
 	eventID := c.Param("event_id")
 
-	err := h.event_service.DeleteEventByID(c.Request.Context(), eventID)
+	err := h.event_service.DeleteEventByTenant(c.Request.Context(), appID, eventID)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -704,26 +942,42 @@ func (h *AdminHandler) DeleteEvent(c *gin.Context) {
 }
 
 // GetEvents godoc
-// @Summary      取得所有事件
-// @Description  取得所有事件
+// @Summary      取得事件列表
+// @Description  分頁取得應用程式的事件
 // @Tags         Admin/Event
 // @Produce      json
-// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Event}  "成功回應，包含所有事件陣列"
+// @Param        app_id  path      string  true  "應用程式 ID"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Param        platform_id   query  int     false  "平台 ID"
+// @Param        is_active     query  bool    false  "是否啟用"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Event}  "成功回應，包含事件陣列，有下一頁時帶入 next_cursor"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events [get]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events [get]
 func (h *AdminHandler) GetEvents(c *gin.Context) {
-	events, err := h.event_service.GetEvents(c.Request.Context())
+	var req datastructure.GetEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	events, nextCursor, err := h.event_service.GetEventsByApplicationID(c.Request.Context(), c.Param("app_id"), &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
 	}
 
-	h.Success(c, events)
+	h.SuccessWithCursor(c, events, nextCursor)
 }
 
 // CreateEventFields godoc
@@ -732,6 +986,7 @@ func (h *AdminHandler) GetEvents(c *gin.Context) {
 // @Tags         Admin/Event
 // @Accept       json
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
 // @Param        request  body  datastructure.EventField  true  "新增事件欄位資料"
 // @Success      200      {object}  datastructure.BaseResponse{data=datastructure.EventField}  "成功回應，包含新事件欄位資料"
@@ -741,7 +996,8 @@ func (h *AdminHandler) GetEvents(c *gin.Context) {
 // @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events/{event_id}/fields [post]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events/{event_id}/fields [post]
 func (h *AdminHandler) CreateEventFields(c *gin.Context) {
 	var req datastructure.CreateEventFieldRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -749,9 +1005,15 @@ func (h *AdminHandler) CreateEventFields(c *gin.Context) {
 		return
 	}
 
+	event, err := h.event_service.GetEventByTenant(c.Request.Context(), c.Param("app_id"), c.Param("event_id"))
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
 	reqField := &datastructure.EventField{
 		Name:        req.Name,
-		EventID:     req.EventID,
+		EventID:     event.ID,
 		DataType:    req.DataType,
 		Description: req.Description,
 		IsRequired:  req.IsRequired,
@@ -783,6 +1045,7 @@ func (h *AdminHandler) CreateEventFields(c *gin.Context) {
 // @Description  取得指定事件欄位
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
 // @Param        field_id  path      string  true  "欄位 ID"
 // @Success      200     {object}  datastructure.BaseResponse{data=datastructure.EventField}  "成功回應，包含應用程式詳細資料"
@@ -792,12 +1055,14 @@ func (h *AdminHandler) CreateEventFields(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps/{app_id}/events/{event_id}/fields/{field_id} [get]
 func (h *AdminHandler) GetEventField(c *gin.Context) {
+	appID := c.Param("app_id")
 	eventID := c.Param("event_id")
 	fieldID := c.Param("field_id")
 
-	field, err := h.event_service.GetEventFieldByEventIDAndID(c.Request.Context(), eventID, fieldID)
+	field, err := h.event_service.GetEventFieldByTenant(c.Request.Context(), appID, eventID, fieldID)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -823,6 +1088,7 @@ func (h *AdminHandler) GetEventField(c *gin.Context) {
 // @Description  更新指定事件欄位
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path  string  true  "事件 ID"
 // @Param        field_id  path  string  true  "欄位 ID"
 // @Param        request  body  datastructure.EventField  true  "更新事件欄位資料"
@@ -833,6 +1099,7 @@ func (h *AdminHandler) GetEventField(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
 // @Router       /admin/apps/{app_id}/events/{event_id}/fields/{field_id} [put]
 func (h *AdminHandler) UpdateEventField(c *gin.Context) {
 	var req datastructure.UpdateEventFieldRequest
@@ -841,6 +1108,7 @@ func (h *AdminHandler) UpdateEventField(c *gin.Context) {
 		return
 	}
 
+	appID := c.Param("app_id")
 	eventID := c.Param("event_id")
 	fieldID := c.Param("field_id")
 	reqField := &datastructure.EventField{
@@ -851,7 +1119,7 @@ func (h *AdminHandler) UpdateEventField(c *gin.Context) {
 		IsRequired:  req.IsRequired,
 	}
 
-	err := h.event_service.UpdateEventFieldByEventIDAndID(c.Request.Context(), eventID, fieldID, reqField)
+	err := h.event_service.UpdateEventFieldByTenant(c.Request.Context(), appID, eventID, fieldID, reqField)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -865,6 +1133,7 @@ func (h *AdminHandler) UpdateEventField(c *gin.Context) {
 // @Description  刪除指定事件欄位
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
 // @Param        field_id  path      string  true  "欄位 ID"
 // @Success      204     "成功，無內容回應"
@@ -874,12 +1143,14 @@ func (h *AdminHandler) UpdateEventField(c *gin.Context) {
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events/{event_id}/fields/{field_id} [delete]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events/{event_id}/fields/{field_id} [delete]
 func (h *AdminHandler) DeleteEventField(c *gin.Context) {
+	appID := c.Param("app_id")
 	eventID := c.Param("event_id")
 	fieldID := c.Param("field_id")
 
-	err := h.event_service.DeleteEventFieldByEventIDAndID(c.Request.Context(), eventID, fieldID)
+	err := h.event_service.DeleteEventFieldByTenant(c.Request.Context(), appID, eventID, fieldID)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -889,23 +1160,41 @@ func (h *AdminHandler) DeleteEventField(c *gin.Context) {
 }
 
 // GetEventFields godoc
-// @Summary      取得指定事件欄位
-// @Description  取得指定事件欄位
+// @Summary      取得事件欄位列表
+// @Description  分頁取得指定事件的欄位
 // @Tags         Admin/Event
 // @Produce      json
+// @Param        app_id  path      string  true  "應用程式 ID"
 // @Param        event_id  path      string  true  "事件 ID"
-// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.EventField}  "成功回應，包含應用程式詳細資料"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.EventField}  "成功回應，包含事件欄位陣列，有下一頁時帶入 next_cursor"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
 // @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
 // @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
 // @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
-// @Router       /admin/events/{event_id}/fields [get]
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/events/{event_id}/fields [get]
 func (h *AdminHandler) GetEventFields(c *gin.Context) {
-	eventID := c.Param("event_id")
+	var req datastructure.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
 
-	eventFields, err := h.event_service.GetEventFields(c.Request.Context(), eventID)
+	event, err := h.event_service.GetEventByTenant(c.Request.Context(), c.Param("app_id"), c.Param("event_id"))
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	eventFields, nextCursor, err := h.event_service.GetEventFields(c.Request.Context(), event.ID, &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -926,5 +1215,561 @@ func (h *AdminHandler) GetEventFields(c *gin.Context) {
 		})
 	}
 
-	h.Success(c, respEventFields)
+	h.SuccessWithCursor(c, respEventFields, nextCursor)
+}
+
+// GetOutboxStats godoc
+// @Summary      取得 outbox 積壓狀態
+// @Description  取得 outbox 中各狀態的訊息數量與最早待送時間
+// @Tags         Admin/Outbox
+// @Produce      json
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.OutboxStats}  "成功回應，包含 outbox 積壓狀態"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/outbox [get]
+func (h *AdminHandler) GetOutboxStats(c *gin.Context) {
+	stats, err := h.outbox_service.GetOutboxStats(c.Request.Context())
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	respStats := datastructure.OutboxStats{
+		Pending:         stats.Pending,
+		Failed:          stats.Failed,
+		Delivered:       stats.Delivered,
+		OldestPendingAt: util.ConvertTimeToTimeStamp(stats.OldestPendingAt),
+	}
+
+	h.Success(c, respStats)
+}
+
+// RedriveOutbox godoc
+// @Summary      重送 outbox 訊息
+// @Description  將失敗與等待中的 outbox 訊息重設為立即重送，最近 30 秒內領取或更新的訊息可能正在傳送而不重設
+// @Tags         Admin/Outbox
+// @Produce      json
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.OutboxRedriveResponse}  "成功回應，包含重送訊息數量"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/outbox/redrive [post]
+func (h *AdminHandler) RedriveOutbox(c *gin.Context) {
+	redriven, err := h.outbox_service.RedriveOutbox(c.Request.Context())
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, datastructure.OutboxRedriveResponse{Redriven: redriven})
+}
+
+// toApplicationAPIKeyResponse 完整密鑰只存在於剛建立的 model，其餘情況僅有前綴
+// GetEventAnalytics godoc
+// @Summary      查詢事件分析
+// @Description  依時間區間彙總應用程式的事件數、不重複 session 數與不重複使用者數，可依平台或屬性分組
+// @Tags         Admin/Analytics
+// @Produce      json
+// @Param        app_id              path   string  true   "應用程式 ID"
+// @Param        from                query  int     true   "起始時間 (Unix 秒數)"
+// @Param        to                  query  int     true   "結束時間 (Unix 秒數，不含)"
+// @Param        interval            query  string  false  "時間區間，預設 hour" Enums(minute, hour, day)
+// @Param        event_id            query  string  false  "事件 ID"
+// @Param        platform_id         query  int     false  "平台 ID"
+// @Param        breakdown_by        query  string  false  "分組方式" Enums(platform, property)
+// @Param        breakdown_property  query  string  false  "分組的屬性名稱，breakdown_by 為 property 時必填"
+// @Param        properties          query  string  false  "屬性篩選，以 properties[key]=value 帶入，例如 properties[plan]=pro"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.EventAnalyticsResponse}  "成功回應，包含彙總與各時間區間的數量"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/analytics/events [get]
+func (h *AdminHandler) GetEventAnalytics(c *gin.Context) {
+	applicationID := c.Param("app_id")
+
+	var req datastructure.GetEventAnalyticsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+	req.Properties = c.QueryMap("properties")
+
+	totals, series, err := h.analytics_service.GetEventMetrics(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toEventAnalyticsResponse(applicationID, &req, totals, series))
+}
+
+// CreateFunnel godoc
+// @Summary      漏斗分析
+// @Description  依序計算在時間窗內完成各步驟事件的 session 或使用者數，步驟間隔以依序第一次完成各步驟的時間計算
+// @Tags         Admin/Analytics
+// @Accept       json
+// @Produce      json
+// @Param        app_id   path  string  true  "應用程式 ID"
+// @Param        request  body  datastructure.CreateFunnelRequest  true  "漏斗步驟與條件"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.FunnelResponse}  "成功回應，包含各步驟人數、轉換率與間隔時間中位數"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/analytics/funnels [post]
+func (h *AdminHandler) CreateFunnel(c *gin.Context) {
+	applicationID := c.Param("app_id")
+
+	var req datastructure.CreateFunnelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	results, err := h.analytics_service.GetFunnel(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toFunnelResponse(applicationID, &req, results))
+}
+
+// CreateRetention godoc
+// @Summary      留存分析
+// @Description  以第一次觸發 cohort 事件的日或週分組，計算之後各週期觸發回訪事件的使用者數，可選擇將匿名 session 排除或以 session_key 計算
+// @Tags         Admin/Analytics
+// @Accept       json
+// @Produce      json
+// @Param        app_id   path  string  true  "應用程式 ID"
+// @Param        request  body  datastructure.CreateRetentionRequest  true  "cohort 與回訪事件及週期"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.RetentionResponse}  "成功回應，包含各 cohort 的留存人數與比例"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/analytics/retention [post]
+func (h *AdminHandler) CreateRetention(c *gin.Context) {
+	applicationID := c.Param("app_id")
+
+	var req datastructure.CreateRetentionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	cohorts, err := h.analytics_service.GetRetention(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toRetentionResponse(applicationID, &req, cohorts))
+}
+
+// CreatePath godoc
+// @Summary      路徑分析
+// @Description  以每個 session 第一次觸發錨點事件的位置為起點，依 direction 取之前或之後 depth 個事件，合併數量最多的 top_n 條路徑為 Sankey 圖的節點與連線
+// @Tags         Admin/Analytics
+// @Accept       json
+// @Produce      json
+// @Param        app_id   path  string  true  "應用程式 ID"
+// @Param        request  body  datastructure.CreatePathRequest  true  "錨點事件、方向與深度"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.PathResponse}  "成功回應，包含路徑的節點與連線"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/analytics/paths [post]
+func (h *AdminHandler) CreatePath(c *gin.Context) {
+	applicationID := c.Param("app_id")
+
+	var req datastructure.CreatePathRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	graph, err := h.analytics_service.GetPaths(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toPathResponse(applicationID, &req, graph))
+}
+
+// GetSessionAnalytics godoc
+// @Summary      查詢 session 分析
+// @Description  計算開始時間在範圍內的 session 數、平均與百分位時長、跳出率與每個 session 的事件數，尚未結束的 session 以最後一筆事件時間推算時長
+// @Tags         Admin/Analytics
+// @Produce      json
+// @Param        app_id        path   string  true   "應用程式 ID"
+// @Param        from          query  int     true   "session 開始時間起點 (Unix 秒數)"
+// @Param        to            query  int     true   "session 開始時間終點 (Unix 秒數，不含)"
+// @Param        platform_id   query  int     false  "平台 ID"
+// @Param        breakdown_by  query  string  false  "分組方式" Enums(platform, day, user_agent)
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.SessionAnalyticsResponse}  "成功回應，包含彙總與各分組的 session 指標"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/apps/{app_id}/analytics/sessions [get]
+func (h *AdminHandler) GetSessionAnalytics(c *gin.Context) {
+	applicationID := c.Param("app_id")
+
+	var req datastructure.GetSessionAnalyticsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	totals, breakdowns, err := h.analytics_service.GetSessionMetrics(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toSessionAnalyticsResponse(applicationID, &req, totals, breakdowns))
+}
+
+// GetAuditLogs godoc
+// @Summary      查詢稽核紀錄
+// @Description  依建立時間由新到舊查詢設定異動紀錄，限定租戶的使用者僅能查詢所屬租戶
+// @Tags         Admin/Audit
+// @Produce      json
+// @Param        cursor          query  string  false  "上一頁回應的 next_cursor"
+// @Param        tenant_id       query  string  false  "租戶 ID"
+// @Param        application_id  query  string  false  "應用程式 ID"
+// @Param        actor_type      query  string  false  "操作者類型" Enums(admin_user, bootstrap, api_key, system)
+// @Param        actor_id        query  string  false  "操作者 ID"
+// @Param        action          query  string  false  "操作" Enums(create, update, delete, rotate, revoke)
+// @Param        resource_type   query  string  false  "資源類型" Enums(tenant, platform, application, api_key, event, event_field)
+// @Param        resource_id     query  string  false  "資源 ID"
+// @Param        from            query  int     false  "起始時間 (Unix 秒數)"
+// @Param        to              query  int     false  "結束時間 (Unix 秒數)"
+// @Param        limit           query  int     false  "筆數，預設 50，最多 200"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.AuditLog}  "成功回應，包含稽核紀錄陣列，有下一頁時帶入 next_cursor"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Router       /admin/audit-logs [get]
+func (h *AdminHandler) GetAuditLogs(c *gin.Context) {
+	var req datastructure.GetAuditLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	auditLogs, nextCursor, err := h.audit_service.GetAuditLogs(c.Request.Context(), adminPrincipal(c).TenantID, "", &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	respAuditLogs := make([]datastructure.AuditLog, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		respAuditLogs = append(respAuditLogs, toAuditLogResponse(auditLog))
+	}
+
+	h.SuccessWithCursor(c, respAuditLogs, nextCursor)
+}
+
+// adminPrincipal 取得 AdminAuthMiddleware 驗證的身分
+func adminPrincipal(c *gin.Context) *service.AdminPrincipal {
+	return c.MustGet(string(shared.AdminPrincipalKey)).(*service.AdminPrincipal)
+}
+
+func toApplicationAPIKeyResponse(apiKey *model.ApplicationApiKey) datastructure.ApplicationAPIKey {
+	return datastructure.ApplicationAPIKey{
+		ID:            apiKey.ID,
+		ApplicationID: apiKey.ApplicationID,
+		KeyPrefix:     apiKey.KeyPrefix,
+		Type:          apiKey.Type,
+		APIKey:        apiKey.APIKey,
+		Scopes:        apiKey.Scopes,
+		ExpiresAt:     util.ConvertTimeToTimeStamp(apiKey.ExpiresAt),
+		LastUsedAt:    util.ConvertTimeToTimeStamp(apiKey.LastUsedAt),
+		RevokedAt:     util.ConvertTimeToTimeStamp(apiKey.RevokedAt),
+		CreatedAt:     util.ConvertTimeToTimeStamp(&apiKey.CreatedAt),
+		UpdatedAt:     util.ConvertTimeToTimeStamp(&apiKey.UpdatedAt),
+		DeletedAt:     util.ConvertGormDeletedAtToTimeStamp(apiKey.DeletedAt),
+	}
+}
+
+func toRateLimitResponse(rateLimit model.RateLimit) *datastructure.RateLimit {
+	return &datastructure.RateLimit{
+		PerSecond: rateLimit.PerSecond,
+		Burst:     rateLimit.Burst,
+	}
+}
+
+func toQuotaResponse(quota model.Quota) *datastructure.Quota {
+	return &datastructure.Quota{
+		MonthlyEventLogs: quota.MonthlyEventLogs,
+		MonthlySessions:  quota.MonthlySessions,
+	}
+}
+
+func toUsageResponse(tenantID string, report *service.UsageReport) datastructure.UsageResponse {
+	resp := datastructure.UsageResponse{
+		TenantID: tenantID,
+		From:     report.From.Format(time.DateOnly),
+		To:       report.To.Format(time.DateOnly),
+		Usages:   make([]*datastructure.Usage, 0, len(report.Usages)),
+		Quotas:   make([]*datastructure.QuotaUsage, 0, len(report.Quotas)),
+	}
+	for _, usage := range report.Usages {
+		resp.Usages = append(resp.Usages, &datastructure.Usage{
+			Date:          usage.UsageDate.Format(time.DateOnly),
+			ApplicationID: usage.ApplicationID,
+			Metric:        usage.Metric,
+			Accepted:      usage.Accepted,
+			Rejected:      usage.Rejected,
+		})
+	}
+	for _, quota := range report.Quotas {
+		resp.Quotas = append(resp.Quotas, &datastructure.QuotaUsage{
+			Metric: quota.Metric,
+			Limit:  quota.Limit,
+			Used:   quota.Used,
+		})
+	}
+	return resp
+}
+
+func toAuditLogResponse(auditLog *model.AuditLog) datastructure.AuditLog {
+	return datastructure.AuditLog{
+		ID:            auditLog.ID,
+		TenantID:      auditLog.TenantID,
+		ApplicationID: auditLog.ApplicationID,
+		ActorType:     auditLog.ActorType,
+		ActorID:       auditLog.ActorID,
+		Action:        auditLog.Action,
+		ResourceType:  auditLog.ResourceType,
+		ResourceID:    auditLog.ResourceID,
+		Before:        auditLog.Before,
+		After:         auditLog.After,
+		TraceID:       auditLog.TraceID,
+		ClientIP:      auditLog.ClientIP,
+		CreatedAt:     util.ConvertTimeToTimeStamp(&auditLog.CreatedAt),
+	}
+}
+
+func toEventAnalyticsResponse(
+	applicationID string,
+	in *datastructure.GetEventAnalyticsRequest,
+	totals []*model.EventMetric,
+	series []*model.EventMetric,
+) datastructure.EventAnalyticsResponse {
+	interval := in.Interval
+	if interval == "" {
+		interval = model.AnalyticsIntervalHour
+	}
+
+	return datastructure.EventAnalyticsResponse{
+		ApplicationID: applicationID,
+		From:          strconv.FormatInt(in.From, 10),
+		To:            strconv.FormatInt(in.To, 10),
+		Interval:      interval,
+		Totals:        toEventMetricResponses(totals),
+		Series:        toEventMetricResponses(series),
+	}
+}
+
+func toEventMetricResponses(metrics []*model.EventMetric) []*datastructure.EventMetric {
+	respMetrics := make([]*datastructure.EventMetric, 0, len(metrics))
+	for _, metric := range metrics {
+		respMetrics = append(respMetrics, &datastructure.EventMetric{
+			Bucket:    util.ConvertTimeToTimeStamp(metric.Bucket),
+			Breakdown: metric.Breakdown,
+			Events:    metric.Events,
+			Sessions:  metric.Sessions,
+			Users:     metric.Users,
+		})
+	}
+	return respMetrics
+}
+
+func toFunnelResponse(
+	applicationID string,
+	in *datastructure.CreateFunnelRequest,
+	results []*model.FunnelResult,
+) datastructure.FunnelResponse {
+	breakdowns := make([]*datastructure.FunnelBreakdown, 0, len(results))
+	for _, result := range results {
+		steps := make([]*datastructure.FunnelStepResult, 0, len(result.Steps))
+		for i, step := range result.Steps {
+			respStep := &datastructure.FunnelStepResult{
+				EventID: in.Steps[i].EventID,
+				Count:   step.Count,
+			}
+			if first := result.Steps[0].Count; first > 0 {
+				respStep.ConversionRate = float64(step.Count) / float64(first)
+			}
+			if i == 0 {
+				respStep.StepConversionRate = 1
+			} else if previous := result.Steps[i-1].Count; previous > 0 {
+				respStep.StepConversionRate = float64(step.Count) / float64(previous)
+			}
+			if step.MedianFromPrevious != nil {
+				seconds := step.MedianFromPrevious.Seconds()
+				respStep.MedianSecondsFromPrevious = &seconds
+			}
+			steps = append(steps, respStep)
+		}
+		breakdowns = append(breakdowns, &datastructure.FunnelBreakdown{
+			Breakdown: result.Breakdown,
+			Steps:     steps,
+		})
+	}
+
+	return datastructure.FunnelResponse{
+		ApplicationID: applicationID,
+		From:          strconv.FormatInt(in.From, 10),
+		To:            strconv.FormatInt(in.To, 10),
+		WindowSeconds: in.WindowSeconds,
+		CountBy:       in.CountBy,
+		Breakdowns:    breakdowns,
+	}
+}
+
+func toRetentionResponse(
+	applicationID string,
+	in *datastructure.CreateRetentionRequest,
+	cohorts []*model.RetentionCohort,
+) datastructure.RetentionResponse {
+	respCohorts := make([]*datastructure.RetentionCohort, 0, len(cohorts))
+	for _, cohort := range cohorts {
+		rates := make([]float64, 0, len(cohort.Retained))
+		for _, retained := range cohort.Retained {
+			var rate float64
+			if cohort.Size > 0 {
+				rate = float64(retained) / float64(cohort.Size)
+			}
+			rates = append(rates, rate)
+		}
+		respCohorts = append(respCohorts, &datastructure.RetentionCohort{
+			Cohort:   util.ConvertTimeToTimeStamp(&cohort.Cohort),
+			Size:     cohort.Size,
+			Retained: cohort.Retained,
+			Rates:    rates,
+		})
+	}
+
+	return datastructure.RetentionResponse{
+		ApplicationID: applicationID,
+		From:          strconv.FormatInt(in.From, 10),
+		To:            strconv.FormatInt(in.To, 10),
+		Granularity:   in.Granularity,
+		Periods:       in.Periods,
+		Anonymous:     in.Anonymous,
+		Cohorts:       respCohorts,
+	}
+}
+
+func toPathResponse(
+	applicationID string,
+	in *datastructure.CreatePathRequest,
+	graph *model.PathGraph,
+) datastructure.PathResponse {
+	nodeIDs := make([]string, 0, len(graph.Nodes))
+	respNodes := make([]*datastructure.PathNode, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		id := strconv.Itoa(node.Step) + ":" + node.EventID
+		nodeIDs = append(nodeIDs, id)
+		respNodes = append(respNodes, &datastructure.PathNode{
+			ID:       id,
+			Step:     node.Step,
+			EventID:  node.EventID,
+			Sessions: node.Sessions,
+		})
+	}
+
+	respEdges := make([]*datastructure.PathEdge, 0, len(graph.Edges))
+	for _, edge := range graph.Edges {
+		respEdges = append(respEdges, &datastructure.PathEdge{
+			Source:   nodeIDs[edge.Source],
+			Target:   nodeIDs[edge.Target],
+			Sessions: edge.Sessions,
+		})
+	}
+
+	return datastructure.PathResponse{
+		ApplicationID: applicationID,
+		From:          strconv.FormatInt(in.From, 10),
+		To:            strconv.FormatInt(in.To, 10),
+		AnchorEventID: in.AnchorEventID,
+		Direction:     in.Direction,
+		Depth:         in.Depth,
+		Sessions:      graph.Sessions,
+		Nodes:         respNodes,
+		Edges:         respEdges,
+	}
+}
+
+func toSessionAnalyticsResponse(
+	applicationID string,
+	in *datastructure.GetSessionAnalyticsRequest,
+	totals *model.SessionMetric,
+	breakdowns []*model.SessionMetric,
+) datastructure.SessionAnalyticsResponse {
+	respBreakdowns := make([]*datastructure.SessionMetric, 0, len(breakdowns))
+	for _, metric := range breakdowns {
+		respBreakdowns = append(respBreakdowns, toSessionMetricResponse(metric))
+	}
+
+	return datastructure.SessionAnalyticsResponse{
+		ApplicationID: applicationID,
+		From:          strconv.FormatInt(in.From, 10),
+		To:            strconv.FormatInt(in.To, 10),
+		BreakdownBy:   in.BreakdownBy,
+		Totals:        toSessionMetricResponse(totals),
+		Breakdowns:    respBreakdowns,
+	}
+}
+
+func toSessionMetricResponse(metric *model.SessionMetric) *datastructure.SessionMetric {
+	resp := &datastructure.SessionMetric{
+		Breakdown:          metric.Breakdown,
+		Sessions:           metric.Sessions,
+		AvgDurationSeconds: metric.AvgDuration.Seconds(),
+		P50DurationSeconds: metric.P50Duration.Seconds(),
+		P90DurationSeconds: metric.P90Duration.Seconds(),
+		P99DurationSeconds: metric.P99Duration.Seconds(),
+	}
+	if metric.Sessions > 0 {
+		resp.BounceRate = float64(metric.Bounces) / float64(metric.Sessions)
+		resp.EventsPerSession = float64(metric.Events) / float64(metric.Sessions)
+	}
+	return resp
 }
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	model "tracking-service/internal/models"
+	service "tracking-service/internal/services"
+	util "tracking-service/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminUserHandler struct {
+	BaseHandler
+	admin_user_service *service.AdminUserService
+}
+
+func NewAdminUserHandler(
+	config *shared.Config,
+	admin_user_service *service.AdminUserService,
+) *AdminUserHandler {
+	return &AdminUserHandler{
+		BaseHandler:        NewBaseHandler(config),
+		admin_user_service: admin_user_service,
+	}
+}
+
+// Login godoc
+// @Summary      後台使用者登入
+// @Description  以帳號密碼登入並取得 token，後續請求以 Authorization: Bearer {token} 帶入；ADMIN_JWT_SECRET 未設定或短於 32 bytes 時停用；同一來源 IP 或帳號嘗試過於頻繁時回傳 429
+// @Tags         Admin/User
+// @Accept       json
+// @Produce      json
+// @Param        request  body  datastructure.AdminLoginRequest  true  "登入資料"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.AdminLoginResponse}  "成功回應，包含 token 與使用者資料"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /admin/login [post]
+func (h *AdminUserHandler) Login(c *gin.Context) {
+	var req datastructure.AdminLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	token, expiresAt, user, err := h.admin_user_service.Login(c.Request.Context(), &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, datastructure.AdminLoginResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresAt:   util.ConvertTimeToTimeStamp(&expiresAt),
+		User:        toAdminUserResponse(user),
+	})
+}
+
+// GetCurrentAdminUser godoc
+// @Summary      取得目前身分
+// @Description  取得目前請求的後台身分，以 ADMIN_API_KEY 驗證時角色為 bootstrap
+// @Tags         Admin/User
+// @Produce      json
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.AdminPrincipal}  "成功回應，包含目前身分"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Security     AdminApiKey
+// @Router       /admin/me [get]
+func (h *AdminUserHandler) GetCurrentAdminUser(c *gin.Context) {
+	principal := adminPrincipal(c)
+
+	h.Success(c, datastructure.AdminPrincipal{
+		UserID:   principal.UserID,
+		Email:    principal.Email,
+		Role:     principal.Role,
+		TenantID: principal.TenantID,
+	})
+}
+
+// CreateAdminUser godoc
+// @Summary      建立後台使用者
+// @Description  建立後台使用者，tenant_admin 必須指定租戶，super_admin 不可指定租戶
+// @Tags         Admin/User
+// @Accept       json
+// @Produce      json
+// @Param        request  body  datastructure.CreateAdminUserRequest  true  "新增後台使用者資料"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.AdminUser}  "成功回應，包含新後台使用者資料"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Security     AdminApiKey
+// @Router       /admin/users [post]
+func (h *AdminUserHandler) CreateAdminUser(c *gin.Context) {
+	var req datastructure.CreateAdminUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	user, err := h.admin_user_service.CreateAdminUser(c.Request.Context(), &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toAdminUserResponse(user))
+}
+
+// GetAdminUser godoc
+// @Summary      取得指定後台使用者
+// @Description  取得指定後台使用者
+// @Tags         Admin/User
+// @Produce      json
+// @Param        user_id  path      string  true  "後台使用者 ID"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.AdminUser}  "成功回應，包含後台使用者資料"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Security     AdminApiKey
+// @Router       /admin/users/{user_id} [get]
+func (h *AdminUserHandler) GetAdminUser(c *gin.Context) {
+	user, err := h.admin_user_service.GetAdminUserByID(c.Request.Context(), c.Param("user_id"))
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toAdminUserResponse(user))
+}
+
+// UpdateAdminUser godoc
+// @Summary      更新指定後台使用者
+// @Description  更新角色、租戶或密碼，異動後該使用者先前取得的 token 即失效
+// @Tags         Admin/User
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path  string  true  "後台使用者 ID"
+// @Param        request  body  datastructure.UpdateAdminUserRequest  true  "更新後台使用者資料"
+// @Success      204     "成功，無內容回應"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Security     AdminApiKey
+// @Router       /admin/users/{user_id} [put]
+func (h *AdminUserHandler) UpdateAdminUser(c *gin.Context) {
+	var req datastructure.UpdateAdminUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	if err := h.admin_user_service.UpdateAdminUser(c.Request.Context(), c.Param("user_id"), &req); err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.SuccessWithoutContent(c)
+}
+
+// DeleteAdminUser godoc
+// @Summary      刪除指定後台使用者
+// @Description  刪除指定後台使用者，該使用者先前取得的 token 即失效
+// @Tags         Admin/User
+// @Produce      json
+// @Param        user_id  path      string  true  "後台使用者 ID"
+// @Success      204     "成功，無內容回應"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Security     AdminApiKey
+// @Router       /admin/users/{user_id} [delete]
+func (h *AdminUserHandler) DeleteAdminUser(c *gin.Context) {
+	if err := h.admin_user_service.DeleteAdminUser(c.Request.Context(), c.Param("user_id")); err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.SuccessWithoutContent(c)
+}
+
+// GetAdminUsers godoc
+// @Summary      取得所有後台使用者
+// @Description  取得所有後台使用者
+// @Tags         Admin/User
+// @Produce      json
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.AdminUser}  "成功回應，包含所有後台使用者陣列"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Security     Bearer
+// @Security     AdminApiKey
+// @Router       /admin/users [get]
+func (h *AdminUserHandler) GetAdminUsers(c *gin.Context) {
+	users, err := h.admin_user_service.GetAdminUsers(c.Request.Context())
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	respUsers := make([]datastructure.AdminUser, 0, len(users))
+	for _, user := range users {
+		respUsers = append(respUsers, toAdminUserResponse(user))
+	}
+
+	h.Success(c, respUsers)
+}
+
+func toAdminUserResponse(user *model.AdminUser) datastructure.AdminUser {
+	return datastructure.AdminUser{
+		ID:          user.ID,
+		Email:       user.Email,
+		Role:        user.Role,
+		TenantID:    user.TenantID,
+		LastLoginAt: util.ConvertTimeToTimeStamp(user.LastLoginAt),
+		CreatedAt:   util.ConvertTimeToTimeStamp(&user.CreatedAt),
+		UpdatedAt:   util.ConvertTimeToTimeStamp(&user.UpdatedAt),
+		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(user.DeletedAt),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	shared "tracking-service/internal"
 	datastructure "tracking-service/internal/datastructures"
 	errdefs "tracking-service/internal/errors"
 
@@ -14,7 +15,15 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-type BaseHandler struct{}
+type BaseHandler struct {
+	quotaExceededStatus int
+}
+
+func NewBaseHandler(config *shared.Config) BaseHandler {
+	return BaseHandler{
+		quotaExceededStatus: config.QuotaExceededHTTPStatus(),
+	}
+}
 
 func (b *BaseHandler) Success(c *gin.Context, data interface{}) {
 	c.JSON(http.StatusOK, datastructure.BaseResponse{
@@ -23,6 +32,15 @@ func (b *BaseHandler) Success(c *gin.Context, data interface{}) {
 	})
 }
 
+// SuccessWithCursor 回傳列表的一頁，nextCursor 為空字串代表沒有下一頁
+func (b *BaseHandler) SuccessWithCursor(c *gin.Context, data interface{}, nextCursor string) {
+	c.JSON(http.StatusOK, datastructure.BaseResponse{
+		Success:    true,
+		Data:       data,
+		NextCursor: nextCursor,
+	})
+}
+
 func (b *BaseHandler) SuccessWithoutData(c *gin.Context) {
 	c.JSON(http.StatusNoContent, datastructure.BaseResponse{Success: true})
 }
@@ -38,49 +56,86 @@ func (b *BaseHandler) ErrorResponse(c *gin.Context, cause error) {
 func (b *BaseHandler) respondWithStatus(c *gin.Context, cause error) {
 	ctx := c.Request.Context()
 
-	switch cause {
-	case errdefs.ErrorNotFound:
+	var validationErr *errdefs.ValidationError
+	if errors.As(cause, &validationErr) {
+		c.JSON(400, datastructure.ErrorResponseWithCode{
+			ErrorResponse: datastructure.ErrorResponse{
+				Success: false,
+				Message: cause.Error(),
+			},
+			Details: validationErr.Details,
+		})
+		return
+	}
+
+	switch {
+	case errors.Is(cause, errdefs.ErrorNotFound):
 		c.JSON(404, datastructure.ErrorResponseWithCode{
 			ErrorResponse: datastructure.ErrorResponse{
 				Success: false,
 				Message: cause.Error(),
 			},
+			Code:    errdefs.ErrorCode(cause),
 			Details: nil,
 		})
 		return
-	case errdefs.ErrorDuplicateKey:
+	case errors.Is(cause, errdefs.ErrorDuplicateKey):
 		c.JSON(409, datastructure.ErrorResponseWithCode{
 			ErrorResponse: datastructure.ErrorResponse{
 				Success: false,
 				Message: cause.Error(),
 			},
+			Code:    errdefs.ErrorCode(cause),
 			Details: nil,
 		})
 		return
-	case errdefs.ErrorInvalidRequest:
+	case errors.Is(cause, errdefs.ErrorInvalidRequest):
 		c.JSON(400, datastructure.ErrorResponseWithCode{
 			ErrorResponse: datastructure.ErrorResponse{
 				Success: false,
 				Message: cause.Error(),
 			},
+			Code:    errdefs.ErrorCode(cause),
 			Details: nil,
 		})
 		return
-	case errdefs.ErrorUnauthorized:
+	case errors.Is(cause, errdefs.ErrorUnauthorized):
 		c.JSON(401, datastructure.ErrorResponseWithCode{
 			ErrorResponse: datastructure.ErrorResponse{
 				Success: false,
 				Message: cause.Error(),
 			},
+			Code:    errdefs.ErrorCode(cause),
 			Details: nil,
 		})
 		return
-	case errdefs.ErrorForbidden:
+	case errors.Is(cause, errdefs.ErrorForbidden):
 		c.JSON(403, datastructure.ErrorResponseWithCode{
 			ErrorResponse: datastructure.ErrorResponse{
 				Success: false,
 				Message: cause.Error(),
 			},
+			Code:    errdefs.ErrorCode(cause),
+			Details: nil,
+		})
+		return
+	case errors.Is(cause, errdefs.ErrorRateLimited):
+		c.JSON(429, datastructure.ErrorResponseWithCode{
+			ErrorResponse: datastructure.ErrorResponse{
+				Success: false,
+				Message: cause.Error(),
+			},
+			Code:    errdefs.ErrorCode(cause),
+			Details: nil,
+		})
+		return
+	case errors.Is(cause, errdefs.ErrorQuotaExceeded):
+		c.JSON(b.quotaExceededStatus, datastructure.ErrorResponseWithCode{
+			ErrorResponse: datastructure.ErrorResponse{
+				Success: false,
+				Message: cause.Error(),
+			},
+			Code:    errdefs.ErrorCode(cause),
 			Details: nil,
 		})
 		return
@@ -105,6 +160,17 @@ func (b *BaseHandler) InvalidInputErrorResponse(c *gin.Context, cause error) {
 		return
 	}
 
+	c.JSON(http.StatusBadRequest, datastructure.ErrorResponseWithCode{
+		ErrorResponse: datastructure.ErrorResponse{
+			Success: false,
+			Message: err.Error(),
+		},
+		Code:    errdefs.ErrorCodeInvalidRequest,
+		Details: validationErrorDetails(c, ve),
+	})
+}
+
+func validationErrorDetails(c *gin.Context, ve validator.ValidationErrors) map[string]string {
 	errorsMap := make(map[string]string)
 	for _, err := range ve {
 		fieldName := getJSONFieldName(err)
@@ -115,14 +181,7 @@ func (b *BaseHandler) InvalidInputErrorResponse(c *gin.Context, cause error) {
 			err.Field(), err.Tag(), err.Param(),
 		)
 	}
-
-	c.JSON(http.StatusBadRequest, datastructure.ErrorResponseWithCode{
-		ErrorResponse: datastructure.ErrorResponse{
-			Success: false,
-			Message: err.Error(),
-		},
-		Details: errorsMap,
-	})
+	return errorsMap
 }
 
 func getJSONFieldName(err validator.FieldError) string {
@@ -172,6 +231,8 @@ func mapValidationErrorToMessage(err validator.FieldError) string {
 		return fmt.Sprintf("%s must be equal to %s", fieldName, err.Param())
 	case "required_without", "required_without_all":
 		return fmt.Sprintf("%s is required when %s is not present", fieldName, err.Param())
+	case "required_if":
+		return fmt.Sprintf("%s is required when %s", fieldName, err.Param())
 	case "required_with":
 		return fmt.Sprintf("%s is required when %s is present", fieldName, err.Param())
 	case "gt":
@@ -184,6 +245,8 @@ func mapValidationErrorToMessage(err validator.FieldError) string {
 		return fmt.Sprintf("%s must be in the future", fieldName)
 	case "mobile":
 		return fmt.Sprintf("%s is invalid mobile phone", fieldName)
+	case "origin":
+		return fmt.Sprintf("%s must be an origin like https://example.com", fieldName)
 	default:
 		return fmt.Sprintf("%s is invalid", fieldName)
 	}
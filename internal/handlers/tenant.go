@@ -1,42 +1,64 @@
 package handler
 
 import (
+	"errors"
 	shared "tracking-service/internal"
 	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
 	service "tracking-service/internal/services"
 	util "tracking-service/internal/utils"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 )
 
 type TenantHandler struct {
 	BaseHandler
-	tenant_service   *service.TenantService
-	app_service      *service.ApplicationService
-	platform_service *service.PlatformService
-	event_service    *service.EventService
+	tenant_service    *service.TenantService
+	app_service       *service.ApplicationService
+	platform_service  *service.PlatformService
+	event_service     *service.EventService
+	usage_service     *service.UsageService
+	audit_service     *service.AuditService
+	analytics_service *service.AnalyticsService
 }
 
 func NewTenantHandler(
+	config *shared.Config,
 	tenant_service *service.TenantService,
 	app_service *service.ApplicationService,
 	platform_service *service.PlatformService,
 	event_service *service.EventService,
+	usage_service *service.UsageService,
+	audit_service *service.AuditService,
+	analytics_service *service.AnalyticsService,
 ) *TenantHandler {
 	return &TenantHandler{
-		tenant_service:   tenant_service,
-		app_service:      app_service,
-		platform_service: platform_service,
-		event_service:    event_service,
+		BaseHandler:       NewBaseHandler(config),
+		tenant_service:    tenant_service,
+		app_service:       app_service,
+		platform_service:  platform_service,
+		event_service:     event_service,
+		usage_service:     usage_service,
+		audit_service:     audit_service,
+		analytics_service: analytics_service,
 	}
 }
 
 // GetPlatforms godoc
 // @Summary      取得平台列表
-// @Description  取得所有平台的名稱列表
+// @Description  分頁取得平台的名稱列表
 // @Tags         Tenant/Platform
 // @Produce      json
-// @Success      200  {object}  datastructure.BaseResponse{data=[]datastructure.Platform}  "成功回應，包含平台陣列"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Success      200  {object}  datastructure.BaseResponse{data=[]datastructure.Platform}  "成功回應，包含平台陣列，有下一頁時帶入 next_cursor"
 // @Failure      400  {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401  {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403  {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
@@ -45,7 +67,13 @@ func NewTenantHandler(
 // @Failure      500  {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
 // @Router       /platforms [get]
 func (h *TenantHandler) GetPlatforms(c *gin.Context) {
-	platforms, err := h.platform_service.GetPlatforms(c.Request.Context())
+	var req datastructure.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	platforms, nextCursor, err := h.platform_service.GetPlatforms(c.Request.Context(), &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -62,7 +90,7 @@ func (h *TenantHandler) GetPlatforms(c *gin.Context) {
 		})
 	}
 
-	h.Success(c, respPlatforms)
+	h.SuccessWithCursor(c, respPlatforms, nextCursor)
 }
 
 // GetApp godoc
@@ -89,17 +117,277 @@ func (h *TenantHandler) GetApp(c *gin.Context) {
 	}
 
 	respApp := datastructure.Application{
-		TenantID:    app.TenantID,
-		Name:        app.Name,
-		Description: app.Description,
-		CreatedAt:   util.ConvertTimeToTimeStamp(&app.CreatedAt),
-		UpdatedAt:   util.ConvertTimeToTimeStamp(&app.UpdatedAt),
-		DeletedAt:   util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
+		TenantID:              app.TenantID,
+		Name:                  app.Name,
+		Description:           app.Description,
+		AllowedOrigins:        app.AllowedOrigins,
+		RateLimit:             toRateLimitResponse(app.RateLimit),
+		SessionTimeoutSeconds: &app.SessionTimeoutSeconds,
+		IngestionValidation:   &app.IngestionValidation,
+		CreatedAt:             util.ConvertTimeToTimeStamp(&app.CreatedAt),
+		UpdatedAt:             util.ConvertTimeToTimeStamp(&app.UpdatedAt),
+		DeletedAt:             util.ConvertGormDeletedAtToTimeStamp(app.DeletedAt),
 	}
 
 	h.Success(c, respApp)
 }
 
+// GetUsage godoc
+// @Summary      取得用量
+// @Description  取得目前應用程式每日的事件日誌與工作階段接受、拒絕數量，以及租戶當月配額用量；用量最多延遲一個寫入間隔
+// @Tags         Tenant/Application
+// @Produce      json
+// @Param        from  query  string  false  "起始日期 (UTC)，預設為當月一日"
+// @Param        to    query  string  false  "結束日期 (UTC)，預設為今日"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.UsageResponse}  "成功回應，包含用量"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/usage [get]
+func (h *TenantHandler) GetUsage(c *gin.Context) {
+	tenantID := c.GetString(string(shared.TenantIDKey))
+
+	var req datastructure.GetUsageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+	// 僅能查詢目前應用程式的每日用量
+	req.ApplicationID = c.GetString(string(shared.TenantApplicationIDKey))
+
+	report, err := h.usage_service.GetUsage(c.Request.Context(), tenantID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toUsageResponse(tenantID, report))
+}
+
+// GetEventAnalytics godoc
+// @Summary      查詢事件分析
+// @Description  依時間區間彙總目前應用程式的事件數、不重複 session 數與不重複使用者數，可依平台或屬性分組
+// @Tags         Tenant/Analytics
+// @Produce      json
+// @Param        from                query  int     true   "起始時間 (Unix 秒數)"
+// @Param        to                  query  int     true   "結束時間 (Unix 秒數，不含)"
+// @Param        interval            query  string  false  "時間區間，預設 hour" Enums(minute, hour, day)
+// @Param        event_id            query  string  false  "事件 ID"
+// @Param        platform_id         query  int     false  "平台 ID"
+// @Param        breakdown_by        query  string  false  "分組方式" Enums(platform, property)
+// @Param        breakdown_property  query  string  false  "分組的屬性名稱，breakdown_by 為 property 時必填"
+// @Param        properties          query  string  false  "屬性篩選，以 properties[key]=value 帶入，例如 properties[plan]=pro"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.EventAnalyticsResponse}  "成功回應，包含彙總與各時間區間的數量"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/analytics/events [get]
+func (h *TenantHandler) GetEventAnalytics(c *gin.Context) {
+	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
+
+	var req datastructure.GetEventAnalyticsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+	req.Properties = c.QueryMap("properties")
+
+	totals, series, err := h.analytics_service.GetEventMetrics(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toEventAnalyticsResponse(applicationID, &req, totals, series))
+}
+
+// CreateFunnel godoc
+// @Summary      漏斗分析
+// @Description  依序計算在時間窗內完成各步驟事件的 session 或使用者數，步驟間隔以依序第一次完成各步驟的時間計算
+// @Tags         Tenant/Analytics
+// @Accept       json
+// @Produce      json
+// @Param        request  body  datastructure.CreateFunnelRequest  true  "漏斗步驟與條件"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.FunnelResponse}  "成功回應，包含各步驟人數、轉換率與間隔時間中位數"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/analytics/funnels [post]
+func (h *TenantHandler) CreateFunnel(c *gin.Context) {
+	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
+
+	var req datastructure.CreateFunnelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	results, err := h.analytics_service.GetFunnel(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toFunnelResponse(applicationID, &req, results))
+}
+
+// CreateRetention godoc
+// @Summary      留存分析
+// @Description  以第一次觸發 cohort 事件的日或週分組，計算之後各週期觸發回訪事件的使用者數，可選擇將匿名 session 排除或以 session_key 計算
+// @Tags         Tenant/Analytics
+// @Accept       json
+// @Produce      json
+// @Param        request  body  datastructure.CreateRetentionRequest  true  "cohort 與回訪事件及週期"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.RetentionResponse}  "成功回應，包含各 cohort 的留存人數與比例"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/analytics/retention [post]
+func (h *TenantHandler) CreateRetention(c *gin.Context) {
+	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
+
+	var req datastructure.CreateRetentionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	cohorts, err := h.analytics_service.GetRetention(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toRetentionResponse(applicationID, &req, cohorts))
+}
+
+// CreatePath godoc
+// @Summary      路徑分析
+// @Description  以每個 session 第一次觸發錨點事件的位置為起點，依 direction 取之前或之後 depth 個事件，合併數量最多的 top_n 條路徑為 Sankey 圖的節點與連線
+// @Tags         Tenant/Analytics
+// @Accept       json
+// @Produce      json
+// @Param        request  body  datastructure.CreatePathRequest  true  "錨點事件、方向與深度"
+// @Success      200      {object}  datastructure.BaseResponse{data=datastructure.PathResponse}  "成功回應，包含路徑的節點與連線"
+// @Failure      400      {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401      {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403      {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404      {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409      {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500      {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/analytics/paths [post]
+func (h *TenantHandler) CreatePath(c *gin.Context) {
+	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
+
+	var req datastructure.CreatePathRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	graph, err := h.analytics_service.GetPaths(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toPathResponse(applicationID, &req, graph))
+}
+
+// GetSessionAnalytics godoc
+// @Summary      查詢 session 分析
+// @Description  計算開始時間在範圍內的 session 數、平均與百分位時長、跳出率與每個 session 的事件數，尚未結束的 session 以最後一筆事件時間推算時長
+// @Tags         Tenant/Analytics
+// @Produce      json
+// @Param        from          query  int     true   "session 開始時間起點 (Unix 秒數)"
+// @Param        to            query  int     true   "session 開始時間終點 (Unix 秒數，不含)"
+// @Param        platform_id   query  int     false  "平台 ID"
+// @Param        breakdown_by  query  string  false  "分組方式" Enums(platform, day, user_agent)
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.SessionAnalyticsResponse}  "成功回應，包含彙總與各分組的 session 指標"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/analytics/sessions [get]
+func (h *TenantHandler) GetSessionAnalytics(c *gin.Context) {
+	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
+
+	var req datastructure.GetSessionAnalyticsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	totals, breakdowns, err := h.analytics_service.GetSessionMetrics(c.Request.Context(), applicationID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	h.Success(c, toSessionAnalyticsResponse(applicationID, &req, totals, breakdowns))
+}
+
+// GetAuditLogs godoc
+// @Summary      查詢稽核紀錄
+// @Description  依建立時間由新到舊查詢目前應用程式的設定異動紀錄
+// @Tags         Tenant/Application
+// @Produce      json
+// @Param        cursor         query  string  false  "上一頁回應的 next_cursor"
+// @Param        actor_type     query  string  false  "操作者類型" Enums(admin_user, bootstrap, api_key, system)
+// @Param        actor_id       query  string  false  "操作者 ID"
+// @Param        action         query  string  false  "操作" Enums(create, update, delete, rotate, revoke)
+// @Param        resource_type  query  string  false  "資源類型" Enums(application, api_key, event, event_field)
+// @Param        resource_id    query  string  false  "資源 ID"
+// @Param        from           query  int     false  "起始時間 (Unix 秒數)"
+// @Param        to             query  int     false  "結束時間 (Unix 秒數)"
+// @Param        limit          query  int     false  "筆數，預設 50，最多 200"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.AuditLog}  "成功回應，包含稽核紀錄陣列，有下一頁時帶入 next_cursor"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/audit-logs [get]
+func (h *TenantHandler) GetAuditLogs(c *gin.Context) {
+	tenantID := c.GetString(string(shared.TenantIDKey))
+	appID := c.GetString(string(shared.TenantApplicationIDKey))
+
+	var req datastructure.GetAuditLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	// 僅能查詢目前應用程式的紀錄
+	auditLogs, nextCursor, err := h.audit_service.GetAuditLogs(c.Request.Context(), tenantID, appID, &req)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	respAuditLogs := make([]datastructure.AuditLog, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		respAuditLogs = append(respAuditLogs, toAuditLogResponse(auditLog))
+	}
+
+	h.SuccessWithCursor(c, respAuditLogs, nextCursor)
+}
+
 // CreateEvent godoc
 // @Summary      建立事件
 // @Description  建立新事件
@@ -282,10 +570,18 @@ func (h *TenantHandler) DeleteEvent(c *gin.Context) {
 
 // GetEvents godoc
 // @Summary      取得事件列表
-// @Description  取得指定應用程式的事件列表
+// @Description  分頁取得指定應用程式的事件列表
 // @Tags         Tenant/Event
 // @Produce      json
-// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.Event}  "成功回應，包含事件陣列"
+// @Param        cursor        query  string  false  "上一頁回應的 next_cursor"
+// @Param        limit         query  int     false  "筆數，預設 50，最多 200"
+// @Param        sort          query  string  false  "排序，預設 created_at" Enums(created_at, -created_at, name, -name)
+// @Param        name          query  string  false  "名稱包含"
+// @Param        created_from  query  int     false  "建立時間起 (Unix 秒數)"
+// @Param        created_to    query  int     false  "建立時間迄 (Unix 秒數)"
+// @Param        platform_id   query  int     false  "平台 ID"
+// @Param        is_active     query  bool    false  "是否啟用"
+// @Success      200     {object}  datastructure.BaseResponse{data=[]datastructure.EventResponse}  "成功回應，包含事件陣列，有下一頁時帶入 next_cursor"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
 // @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
 // @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
@@ -295,7 +591,14 @@ func (h *TenantHandler) DeleteEvent(c *gin.Context) {
 // @Router       /tenant/events [get]
 func (h *TenantHandler) GetEvents(c *gin.Context) {
 	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
-	events, err := h.event_service.GetEventsByApplicationID(c.Request.Context(), applicationID)
+
+	var req datastructure.GetEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	events, nextCursor, err := h.event_service.GetEventsByApplicationID(c.Request.Context(), applicationID, &req)
 	if err != nil {
 		h.ErrorResponse(c, err)
 		return
@@ -334,7 +637,7 @@ func (h *TenantHandler) GetEvents(c *gin.Context) {
 		})
 	}
 
-	h.Success(c, respEvents)
+	h.SuccessWithCursor(c, respEvents, nextCursor)
 }
 
 // CreateEventField godoc
@@ -505,10 +808,11 @@ func (h *TenantHandler) DeleteEventField(c *gin.Context) {
 
 // CreateEventLog godoc
 // @Summary      建立事件日誌
-// @Description  建立新事件日誌
+// @Description  建立新事件日誌，未帶 session_id 時以 session_key 取得或建立 session，並以請求的 User-Agent 與 IP 填入；事件須為啟用中且平台相符、session 須存在且尚未結束，應用程式設定 ingestion_validation 為 tag 時改為寫入並於 violations 記錄錯誤代碼
 // @Tags         Tenant/Event
 // @Produce      json
 // @Param        event_id  path  string  true  "事件 ID"
+// @Param        Idempotency-Key  header  string  false  "冪等鍵，未提供 message_id 時使用"
 // @Param        request  body  datastructure.CreateEventLogRequest  true  "新增事件日誌資料"
 // @Success      200     {object}  datastructure.BaseResponse{data=datastructure.EventLog}  "成功回應，包含新事件日誌資料"
 // @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
@@ -525,14 +829,31 @@ func (h *TenantHandler) CreateEventLog(c *gin.Context) {
 		return
 	}
 
-	appID := c.GetString(string(shared.TenantApplicationIDKey))
+	messageID := req.MessageID
+	if messageID == "" {
+		messageID = c.GetHeader("Idempotency-Key")
+	}
+	if len(messageID) > 128 {
+		h.ErrorResponse(c, errdefs.NewValidationError(map[string]string{
+			"message_id": "message_id must be at most 128 characters",
+		}))
+		return
+	}
+
+	application := tenantApplication(c)
 	eventID := c.Param("event_id")
 	reqEventLog := datastructure.EventLog{
-		ApplicationID: appID,
-		SessionID:     req.SessionID,
-		EventID:       eventID,
-		PlatformID:    req.PlatformID,
-		Properties:    req.Properties,
+		MessageID:           messageID,
+		TenantID:            application.TenantID,
+		ApplicationID:       application.ID,
+		SessionID:           req.SessionID,
+		SessionKey:          req.SessionKey,
+		EventID:             eventID,
+		PlatformID:          req.PlatformID,
+		Properties:          req.Properties,
+		UserAgent:           c.Request.UserAgent(),
+		IPAddress:           c.ClientIP(),
+		IngestionValidation: application.IngestionValidation,
 	}
 
 	eventLog, err := h.event_service.CreateEventLog(c.Request.Context(), &reqEventLog)
@@ -542,17 +863,129 @@ func (h *TenantHandler) CreateEventLog(c *gin.Context) {
 	}
 
 	respEventLog := datastructure.EventLog{
+		MessageID:     eventLog.MessageID,
 		ApplicationID: eventLog.ApplicationID,
 		SessionID:     eventLog.SessionID,
 		EventID:       eventLog.EventID,
 		PlatformID:    eventLog.PlatformID,
 		Properties:    eventLog.Properties,
+		Violations:    eventLog.Violations,
 		CreatedAt:     util.ConvertTimeToTimeStamp(&eventLog.CreatedAt),
 	}
 
 	h.Success(c, respEventLog)
 }
 
+// CreateEventLogBatch godoc
+// @Summary      批次建立事件日誌
+// @Description  一次建立多筆事件日誌，逐筆驗證並回傳各筆處理結果，未帶 session_id 的項目以 session_key 取得或建立 session
+// @Tags         Tenant/Event
+// @Accept       json
+// @Produce      json
+// @Param        request  body  datastructure.CreateEventLogBatchRequest  true  "批次事件日誌資料"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.EventLogBatchResponse}  "成功回應，包含各筆事件日誌處理結果"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/event-logs:batch [post]
+func (h *TenantHandler) CreateEventLogBatch(c *gin.Context) {
+	var req datastructure.CreateEventLogBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+	if err := h.event_service.ValidateEventLogBatchSize(len(req.Events)); err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	application := tenantApplication(c)
+	tenantID, appID := application.TenantID, application.ID
+	results := make([]datastructure.EventLogBatchResult, len(req.Events))
+	reqEventLogs := make([]*datastructure.EventLog, 0, len(req.Events))
+	positions := make([]int, 0, len(req.Events))
+	for i, item := range req.Events {
+		results[i].Index = i
+		if err := binding.Validator.ValidateStruct(&item); err != nil {
+			results[i].Message = errdefs.ErrorInvalidRequest.Error()
+			results[i].Code = errdefs.ErrorCodeInvalidRequest
+			var ve validator.ValidationErrors
+			if errors.As(err, &ve) {
+				results[i].Details = validationErrorDetails(c, ve)
+			}
+			continue
+		}
+
+		reqEventLogs = append(reqEventLogs, &datastructure.EventLog{
+			TenantID:            tenantID,
+			ApplicationID:       appID,
+			SessionID:           item.SessionID,
+			SessionKey:          item.SessionKey,
+			EventID:             item.EventID,
+			PlatformID:          item.PlatformID,
+			Properties:          item.Properties,
+			ClientTimestamp:     item.ClientTimestamp,
+			MessageID:           item.MessageID,
+			UserAgent:           c.Request.UserAgent(),
+			IPAddress:           c.ClientIP(),
+			IngestionValidation: application.IngestionValidation,
+		})
+		positions = append(positions, i)
+	}
+
+	// 未通過驗證的資料不會進入服務層，於此計入拒絕數量
+	h.usage_service.Record(tenantID, appID, model.UsageMetricEventLogs, 0, len(req.Events)-len(reqEventLogs))
+
+	eventLogs, errs, err := h.event_service.CreateEventLogBatch(c.Request.Context(), appID, reqEventLogs)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	for j, i := range positions {
+		if errs[j] != nil {
+			results[i].Message = errs[j].Error()
+			results[i].Code = errdefs.ErrorCode(errs[j])
+			var validationErr *errdefs.ValidationError
+			if errors.As(errs[j], &validationErr) {
+				results[i].Details = validationErr.Details
+			}
+			continue
+		}
+
+		eventLog := eventLogs[j]
+		results[i].Success = true
+		results[i].EventLog = &datastructure.EventLog{
+			ID:              eventLog.ID,
+			MessageID:       eventLog.MessageID,
+			ApplicationID:   eventLog.ApplicationID,
+			SessionID:       eventLog.SessionID,
+			EventID:         eventLog.EventID,
+			PlatformID:      eventLog.PlatformID,
+			Properties:      eventLog.Properties,
+			ClientTimestamp: util.ConvertTimeToTimeStamp(eventLog.ClientTimestamp),
+			Violations:      eventLog.Violations,
+			CreatedAt:       util.ConvertTimeToTimeStamp(&eventLog.CreatedAt),
+		}
+	}
+
+	resp := datastructure.EventLogBatchResponse{
+		Results: results,
+	}
+	for _, result := range results {
+		if result.Success {
+			resp.Accepted++
+		} else {
+			resp.Rejected++
+		}
+	}
+
+	h.Success(c, resp)
+}
+
 // CreateSession godoc
 // @Summary      建立會話
 // @Description  建立新會話
@@ -652,6 +1085,53 @@ func (h *TenantHandler) GetSession(c *gin.Context) {
 	h.Success(c, respSession)
 }
 
+// GetSessionByKey godoc
+// @Summary      以 session_key 取得會話
+// @Description  取得應用程式下指定 session_key 的會話
+// @Tags         Tenant/Session
+// @Produce      json
+// @Param        session_key  query     string  true  "會話鍵"
+// @Success      200     {object}  datastructure.BaseResponse{data=datastructure.Session}  "成功回應，包含會話詳細資料"
+// @Failure      400     {object}  datastructure.ErrorResponseWithCode "錯誤回應：無效請求"
+// @Failure      401     {object}  datastructure.ErrorResponseWithCode "錯誤回應：未授權"
+// @Failure      403     {object}  datastructure.ErrorResponseWithCode "錯誤回應：禁止訪問"
+// @Failure      404     {object}  datastructure.ErrorResponseWithCode "錯誤回應：找不到資源"
+// @Failure      409     {object}  datastructure.ErrorResponseWithCode "錯誤回應：重複鍵"
+// @Failure      500     {object}  datastructure.ErrorResponseWithCode "錯誤回應：伺服器錯誤"
+// @Router       /tenant/sessions [get]
+func (h *TenantHandler) GetSessionByKey(c *gin.Context) {
+	var req datastructure.GetSessionByKeyRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.InvalidInputErrorResponse(c, err)
+		return
+	}
+
+	applicationID := c.GetString(string(shared.TenantApplicationIDKey))
+	session, err := h.app_service.GetSessionByApplicationIDAndKey(c.Request.Context(), applicationID, req.SessionKey)
+	if err != nil {
+		h.ErrorResponse(c, err)
+		return
+	}
+
+	endedAt := util.ConvertTimeToTimeStamp(session.EndedAt)
+	respSession := datastructure.Session{
+		ID:            session.ID,
+		ApplicationID: session.ApplicationID,
+		PlatformID:    session.PlatformID,
+		SessionKey:    session.SessionKey,
+		UserID:        session.UserID,
+		UserAgent:     session.UserAgent,
+		IPAddress:     session.IPAddress,
+		StartedAt:     util.ConvertTimeToTimeStamp(&session.StartedAt),
+		EndedAt:       &endedAt,
+		CreatedAt:     util.ConvertTimeToTimeStamp(&session.CreatedAt),
+		UpdatedAt:     util.ConvertTimeToTimeStamp(&session.UpdatedAt),
+		DeletedAt:     util.ConvertGormDeletedAtToTimeStamp(session.DeletedAt),
+	}
+
+	h.Success(c, respSession)
+}
+
 // UpdateSession godoc
 // @Summary      更新會話
 // @Description  更新指定會話
@@ -711,3 +1191,8 @@ func (h *TenantHandler) DeleteSession(c *gin.Context) {
 
 	h.SuccessWithoutContent(c)
 }
+
+// tenantApplication 取得驗證 middleware 放入的應用程式
+func tenantApplication(c *gin.Context) *model.Application {
+	return c.MustGet(string(shared.TenantApplicationKey)).(*model.Application)
+}
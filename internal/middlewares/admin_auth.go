@@ -1,28 +1,85 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
-
 	shared "tracking-service/internal"
+	errdefs "tracking-service/internal/errors"
+	service "tracking-service/internal/services"
+	util "tracking-service/internal/utils"
 
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
 )
 
-func AdminAuthMiddleware(config *shared.Config) gin.HandlerFunc {
+// AdminAuthMiddleware 優先以 Authorization Bearer token 驗證後台使用者，未帶入時才接受 x-api-key 作為 bootstrap 身分
+func AdminAuthMiddleware(admin_user_service *service.AdminUserService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
-		apiKey := c.Request.Header.Get("x-api-key")
 
-		if apiKey == "" {
-			log.WithContext(ctx).Warnf("Invalid API key '%s' for path %v", apiKey, c.Request.URL.Path)
+		var (
+			principal *service.AdminPrincipal
+			err       error
+		)
+		if authHeader := c.Request.Header.Get("Authorization"); authHeader != "" {
+			token, parseErr := util.ParseBearerToken(authHeader)
+			if parseErr != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+				return
+			}
+			principal, err = admin_user_service.Authenticate(ctx, token)
+		} else {
+			principal, err = admin_user_service.AuthenticateAPIKey(c.Request.Header.Get("x-api-key"))
+		}
+		if err != nil {
+			log.WithContext(ctx).Warnf("Invalid admin credential for path %v", c.Request.URL.Path)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
 			return
 		}
 
-		if apiKey != config.AdminApiKey {
-			log.WithContext(ctx).Warnf("Invalid API key '%s' for path %v", apiKey, c.Request.URL.Path)
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		c.Set(string(shared.AdminPrincipalKey), principal)
+		c.Request = c.Request.WithContext(service.WithAuditActor(ctx, principal.AuditActor(c.ClientIP())))
+		c.Next()
+	}
+}
+
+// RequireAdminRole 須置於 AdminAuthMiddleware 之後，角色不符時回傳 403
+func RequireAdminRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := c.MustGet(string(shared.AdminPrincipalKey)).(*service.AdminPrincipal)
+		if !ok || !principal.HasRole(roles...) {
+			log.WithContext(c.Request.Context()).Warnf("Admin role not allowed for path %v", c.Request.URL.Path)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": errdefs.ErrorForbidden.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireAdminTenantAccess 須置於 AdminAuthMiddleware 之後，限定租戶的使用者僅能存取路徑中所屬租戶的資源
+func RequireAdminTenantAccess(admin_user_service *service.AdminUserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		principal := c.MustGet(string(shared.AdminPrincipalKey)).(*service.AdminPrincipal)
+
+		var err error
+		if tenantID := c.Param("tenant_id"); tenantID != "" {
+			err = admin_user_service.AuthorizeTenant(principal, tenantID)
+		}
+		if appID := c.Param("app_id"); err == nil && appID != "" {
+			err = admin_user_service.AuthorizeApplication(ctx, principal, appID)
+		}
+		if err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case errors.Is(err, errdefs.ErrorNotFound):
+				status = http.StatusNotFound
+			case errors.Is(err, errdefs.ErrorForbidden):
+				log.WithContext(ctx).Warnf("Admin user %s not allowed for path %v", principal.UserID, c.Request.URL.Path)
+				status = http.StatusForbidden
+			}
+			c.AbortWithStatusJSON(status, gin.H{"error": err.Error()})
 			return
 		}
 
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+	shared "tracking-service/internal"
+	errdefs "tracking-service/internal/errors"
+	service "tracking-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// QuotaMiddleware 須置於 TenantAuthMiddleware 之後，寫入 cost 筆後超過租戶當月配額時依設定回傳 402 或 429，達軟性上限時加上警告標頭
+func QuotaMiddleware(config *shared.Config, service *service.UsageService, metric string, cost RequestCost) gin.HandlerFunc {
+	exceededStatus := config.QuotaExceededHTTPStatus()
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := c.GetString(string(shared.TenantIDKey))
+		appID := c.GetString(string(shared.TenantApplicationIDKey))
+
+		n := cost(c)
+		if c.IsAborted() {
+			return
+		}
+		decision, err := service.CheckQuota(ctx, tenantID, metric, n)
+		if err != nil {
+			// 無法取得配額時放行，避免影響事件寫入
+			log.WithContext(ctx).WithError(err).Warnf("Failed to check quota for tenant %s", tenantID)
+			c.Next()
+			return
+		}
+
+		if decision.Limit > 0 {
+			c.Header("X-Quota-Limit", strconv.FormatInt(decision.Limit, 10))
+			c.Header("X-Quota-Remaining", strconv.FormatInt(max(decision.Limit-decision.Used, 0), 10))
+		}
+		if decision.Warning {
+			c.Header("X-Quota-Warning", fmt.Sprintf("%s usage has reached %d%% of the monthly quota", metric, decision.Used*100/decision.Limit))
+		}
+
+		if !decision.Allowed {
+			service.Record(tenantID, appID, metric, 0, n)
+			log.WithContext(ctx).Warnf("Monthly %s quota exceeded for tenant %s", metric, tenantID)
+			c.AbortWithStatusJSON(exceededStatus, gin.H{"error": errdefs.ErrorQuotaExceeded.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
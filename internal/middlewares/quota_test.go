@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+	service "tracking-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type fakeTenantRepository struct {
+	repository.TenantRepository
+	tenant *model.Tenant
+}
+
+func (r *fakeTenantRepository) GetTenantByID(context.Context, string) (*model.Tenant, error) {
+	return r.tenant, nil
+}
+
+type fakeUsageRepository struct {
+	repository.UsageRepository
+	accepted int64
+}
+
+func (r *fakeUsageRepository) SumUsageByTenant(context.Context, string, time.Time, time.Time) ([]*repository.UsageTotal, error) {
+	return []*repository.UsageTotal{{Metric: model.UsageMetricEventLogs, Accepted: r.accepted}}, nil
+}
+
+func TestQuotaMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		exceededStatus int
+		used           int64
+		body           string
+		wantStatus     int
+	}{
+		{name: "batch within quota", used: 7, body: `{"events":[{},{},{}]}`, wantStatus: http.StatusOK},
+		{name: "batch over quota", used: 8, body: `{"events":[{},{},{}]}`, wantStatus: http.StatusPaymentRequired},
+		{name: "configured too many requests", exceededStatus: http.StatusTooManyRequests, used: 10, body: `{"events":[{}]}`, wantStatus: http.StatusTooManyRequests},
+		{name: "unparsable body counts as one", used: 9, body: `not json`, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &shared.Config{QuotaExceededStatus: tt.exceededStatus}
+			usage := service.NewUsageService(config,
+				&fakeUsageRepository{accepted: tt.used},
+				&fakeTenantRepository{tenant: &model.Tenant{ID: "tenant-1", Quota: model.Quota{MonthlyEventLogs: 10}}},
+			)
+
+			r := gin.New()
+			r.POST("/event-logs/batch",
+				func(c *gin.Context) { c.Set(string(shared.TenantIDKey), "tenant-1") },
+				QuotaMiddleware(config, usage, model.UsageMetricEventLogs, EventLogBatchCost(1<<20)),
+				func(c *gin.Context) { c.Status(http.StatusOK) },
+			)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/event-logs/batch", strings.NewReader(tt.body)))
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
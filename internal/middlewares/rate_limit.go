@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	shared "tracking-service/internal"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	service "tracking-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// RequestCost 回傳請求寫入的數量，速率限制與配額依此計算
+type RequestCost func(c *gin.Context) int
+
+// SingleRequestCost 每個請求計為一筆
+func SingleRequestCost(*gin.Context) int {
+	return 1
+}
+
+// EventLogBatchCost 以批次請求中的事件數量計算，無法解析時計為一筆並交由 handler 回傳錯誤；
+// 請求內容超過 maxBodySize 時回傳 413 並中止請求
+func EventLogBatchCost(maxBodySize int64) RequestCost {
+	return func(c *gin.Context) int {
+		if size, ok := c.Get(string(shared.EventLogBatchSizeKey)); ok {
+			return size.(int)
+		}
+
+		size := 1
+		body, ok := readRequestBody(c, maxBodySize)
+		if !ok {
+			return 0
+		}
+		var req struct {
+			Events []json.RawMessage `json:"events"`
+		}
+		if json.Unmarshal(body, &req) == nil && len(req.Events) > 0 {
+			size = len(req.Events)
+		}
+		c.Set(string(shared.EventLogBatchSizeKey), size)
+		return size
+	}
+}
+
+// readRequestBody 讀取至多 limit bytes 的請求內容後放回，handler 仍可綁定；超過上限時回傳 413 並中止請求
+func readRequestBody(c *gin.Context, limit int64) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, limit))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": errdefs.ErrorBodyTooLarge.Error()})
+			return nil, false
+		}
+		// 其他讀取錯誤交由 handler 綁定時回傳
+		return nil, true
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+// RateLimitMiddleware 須置於 TenantAuthMiddleware 之後，依 cost 取得令牌，超過應用程式或租戶的速率限制時回傳 429
+func RateLimitMiddleware(service *service.RateLimitService, cost RequestCost) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		value, _ := c.Get(string(shared.TenantApplicationKey))
+		application, ok := value.(*model.Application)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		n := cost(c)
+		if c.IsAborted() {
+			return
+		}
+		decision, err := service.Allow(ctx, application, n)
+		if err != nil {
+			// 無法取得限制設定時放行，避免影響事件寫入
+			log.WithContext(ctx).WithError(err).Warnf("Failed to check rate limit for application %s", application.ID)
+			c.Next()
+			return
+		}
+
+		if decision.Limit > 0 {
+			c.Header("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+			c.Header("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+			c.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.Reset)))
+		}
+
+		if !decision.Allowed {
+			if !decision.ExceedsBurst {
+				c.Header("Retry-After", strconv.Itoa(max(ceilSeconds(decision.RetryAfter), 1)))
+			}
+			log.WithContext(ctx).Warnf("Rate limit exceeded on %s %s for application %s", decision.Scope, c.Request.URL.Path, application.ID)
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": decision.Err().Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AdminLoginRateLimitMiddleware 依來源 IP 與登入帳號限制後台登入嘗試，超過時回傳 429；請求內容超過上限時回傳 413
+func AdminLoginRateLimitMiddleware(config *shared.Config, service *service.RateLimitService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var req struct {
+			Email string `json:"email"`
+		}
+		body, ok := readRequestBody(c, config.MaxRequestBodySize)
+		if !ok {
+			return
+		}
+		_ = json.Unmarshal(body, &req)
+
+		decision := service.AllowLogin(ctx, c.ClientIP(), req.Email)
+		c.Header("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.Reset)))
+
+		if !decision.Allowed {
+			c.Header("Retry-After", strconv.Itoa(max(ceilSeconds(decision.RetryAfter), 1)))
+			log.WithContext(ctx).Warnf("Admin login rate limit exceeded on %s from %s", decision.Scope, c.ClientIP())
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": errdefs.ErrorRateLimited.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	service "tracking-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRateLimitMiddlewareRetryAfter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		wantStatus     int
+		wantRetryAfter string
+	}{
+		{name: "within burst", body: `{"events":[{},{},{}]}`, wantStatus: http.StatusOK},
+		{name: "drained bucket", body: `{"events":[{},{},{},{},{}]}`, wantStatus: http.StatusTooManyRequests, wantRetryAfter: "1"},
+		{name: "batch larger than burst", body: `{"events":[{},{},{},{},{},{},{},{},{},{},{}]}`, wantStatus: http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rateLimit := service.NewRateLimitService(&fakeTenantRepository{tenant: &model.Tenant{ID: "tenant-1"}})
+			application := &model.Application{ID: "app-1", TenantID: "tenant-1", RateLimit: model.RateLimit{PerSecond: 10}}
+
+			r := gin.New()
+			r.POST("/event-logs/batch",
+				func(c *gin.Context) { c.Set(string(shared.TenantApplicationKey), application) },
+				RateLimitMiddleware(rateLimit, EventLogBatchCost(1<<20)),
+				func(c *gin.Context) { c.Status(http.StatusOK) },
+			)
+
+			// 先取得 6 個令牌，剩餘 4 個
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/event-logs/batch", strings.NewReader(`{"events":[{},{},{},{},{},{}]}`)))
+
+			w = httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/event-logs/batch", strings.NewReader(tt.body)))
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestRequestBodyTooLarge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	config := &shared.Config{MaxRequestBodySize: 32}
+	rateLimit := service.NewRateLimitService(&fakeTenantRepository{tenant: &model.Tenant{ID: "tenant-1"}})
+	application := &model.Application{ID: "app-1", TenantID: "tenant-1", RateLimit: model.RateLimit{PerSecond: 10}}
+
+	r := gin.New()
+	r.POST("/event-logs/batch",
+		func(c *gin.Context) { c.Set(string(shared.TenantApplicationKey), application) },
+		RateLimitMiddleware(rateLimit, EventLogBatchCost(config.MaxRequestBodySize)),
+		func(c *gin.Context) { c.Status(http.StatusOK) },
+	)
+	r.POST("/admin/login",
+		AdminLoginRateLimitMiddleware(config, rateLimit),
+		func(c *gin.Context) { c.Status(http.StatusOK) },
+	)
+
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "batch within limit", path: "/event-logs/batch", body: `{"events":[{},{}]}`, wantStatus: http.StatusOK},
+		{name: "batch over limit", path: "/event-logs/batch", body: `{"events":[{},{},{},{},{},{},{},{}]}`, wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "login within limit", path: "/admin/login", body: `{"email":"a@example.com"}`, wantStatus: http.StatusOK},
+		{name: "login over limit", path: "/admin/login", body: `{"email":"someone@example.com","password":"secret"}`, wantStatus: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body)))
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	errdefs "tracking-service/internal/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAction 供 /resource:action 形式的路由使用，gin 無法註冊含冒號的固定路徑，
+// 以參數接收後於其他 middleware 之前比對，不符時回傳 404
+func RequireAction(param, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param(param) != action {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": errdefs.ErrorNotFound.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -2,13 +2,17 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 	shared "tracking-service/internal"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
 	service "tracking-service/internal/services"
 
 	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
 )
 
-func TenantAuthMiddleware(service *service.ApplicationService) gin.HandlerFunc {
+func TenantAuthMiddleware(app_service *service.ApplicationService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 		apiKey := c.Request.Header.Get("x-api-key")
@@ -18,14 +22,55 @@ func TenantAuthMiddleware(service *service.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
-		application, err := service.ValidateAPIKey(ctx, apiKey)
+		application, applicationApiKey, err := app_service.ValidateAPIKey(ctx, apiKey)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
 			return
 		}
 
+		if err := app_service.AuthorizeOrigin(application, applicationApiKey, c.GetHeader("Origin"), c.GetHeader("Referer")); err != nil {
+			log.WithContext(ctx).Warnf("Origin '%s' not allowed for application %s", c.GetHeader("Origin"), application.ID)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set(string(shared.TenantApplicationKey), application)
 		c.Set(string(shared.TenantApplicationIDKey), application.ID)
 		c.Set(string(shared.TenantIDKey), application.TenantID)
+		c.Set(string(shared.TenantAPIKeyScopesKey), []string(applicationApiKey.Scopes))
+		c.Set(string(shared.TenantAPIKeyTypeKey), applicationApiKey.Type)
+		c.Request = c.Request.WithContext(service.WithAuditActor(ctx, &service.AuditActor{
+			Type:     model.AuditActorAPIKey,
+			ID:       applicationApiKey.ID,
+			ClientIP: c.ClientIP(),
+		}))
+		c.Next()
+	}
+}
+
+// RequireScope 須置於 TenantAuthMiddleware 之後，密鑰未具備指定權限範圍時回傳 403
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scopes := c.GetStringSlice(string(shared.TenantAPIKeyScopesKey))
+		if !slices.Contains(scopes, scope) {
+			log.WithContext(c.Request.Context()).Warnf("API key lacks scope '%s' for path %v", scope, c.Request.URL.Path)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": errdefs.ErrorForbidden.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireKeyType 須置於 TenantAuthMiddleware 之後，限制僅指定類型的密鑰可存取
+func RequireKeyType(keyType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(string(shared.TenantAPIKeyTypeKey)) != keyType {
+			log.WithContext(c.Request.Context()).Warnf("API key type not allowed for path %v", c.Request.URL.Path)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": errdefs.ErrorForbidden.Error()})
+			return
+		}
+
 		c.Next()
 	}
 }
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AdminUser 為後台使用者，TenantID 僅 tenant_admin 與 read_only 角色使用
+type AdminUser struct {
+	ID           string         `gorm:"primaryKey;column:id"`
+	Email        string         `gorm:"column:email;not null"`
+	PasswordHash string         `gorm:"column:password_hash;not null"`
+	Role         string         `gorm:"column:role;not null"`
+	TenantID     *string        `gorm:"column:tenant_id;index"`
+	LastLoginAt  *time.Time     `gorm:"column:last_login_at"`
+	CreatedAt    time.Time      `gorm:"column:created_at;not null"`
+	UpdatedAt    time.Time      `gorm:"column:updated_at;not null"`
+	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at" sql:"index"`
+}
+
+func (AdminUser) TableName() string {
+	return "tracking.admin_users"
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"time"
+)
+
+// 分析的時間區間
+const (
+	AnalyticsIntervalMinute = "minute"
+	AnalyticsIntervalHour   = "hour"
+	AnalyticsIntervalDay    = "day"
+)
+
+// 分析的分組方式
+const (
+	AnalyticsBreakdownPlatform = "platform"
+	AnalyticsBreakdownProperty = "property"
+)
+
+// EventMetric 為 ClickHouse 彙總的事件數、不重複 session 數與不重複使用者數
+// Bucket 為時間區間起點，未分時間區間時為 nil；Breakdown 未分組時為空字串
+type EventMetric struct {
+	Bucket    *time.Time
+	Breakdown string
+	Events    int64
+	Sessions  int64
+	Users     int64
+}
+
+// 分析的計算對象，user 僅計算有 user_id 的 session
+const (
+	AnalyticsCountBySession = "session"
+	AnalyticsCountByUser    = "user"
+)
+
+// FunnelResult 為單一分組的漏斗結果，Steps 與請求的步驟同順序
+type FunnelResult struct {
+	Breakdown string
+	Steps     []*FunnelStepResult
+}
+
+// FunnelStepResult MedianFromPrevious 為與前一步驟間隔的中位數，第一步或無人完成時為 nil
+type FunnelStepResult struct {
+	Count              int64
+	MedianFromPrevious *time.Duration
+}
+
+// 留存分析的週期
+const (
+	AnalyticsGranularityDay  = "day"
+	AnalyticsGranularityWeek = "week"
+)
+
+// 留存分析中沒有 user_id 的匿名 session 的計算方式
+const (
+	AnalyticsAnonymousExclude    = "exclude"
+	AnalyticsAnonymousSessionKey = "session_key"
+)
+
+// RetentionCohort Retained 的第 k 筆為在 cohort 後第 k 個週期回訪的人數，第 0 筆為同一週期
+type RetentionCohort struct {
+	Cohort   time.Time
+	Size     int64
+	Retained []int64
+}
+
+// 路徑分析的方向，相對於錨點事件
+const (
+	AnalyticsDirectionBefore = "before"
+	AnalyticsDirectionAfter  = "after"
+)
+
+// EventPath Events 由錨點事件起依方向排列，第 0 筆為錨點事件
+type EventPath struct {
+	Events   []string
+	Sessions int64
+}
+
+// PathResult Sessions 為觸發錨點事件的 session 數，Paths 僅包含數量最多的路徑
+type PathResult struct {
+	Sessions int64
+	Paths    []*EventPath
+}
+
+// PathNode Step 為與錨點事件相距的步數，之前的事件為負數
+type PathNode struct {
+	Step     int
+	EventID  string
+	Sessions int64
+}
+
+// PathEdge Source 與 Target 為 PathGraph.Nodes 的索引，依事件發生順序由 Source 指向 Target
+type PathEdge struct {
+	Source   int
+	Target   int
+	Sessions int64
+}
+
+type PathGraph struct {
+	Sessions int64
+	Nodes    []*PathNode
+	Edges    []*PathEdge
+}
+
+// session 分析的分組方式，platform 沿用 AnalyticsBreakdownPlatform
+const (
+	AnalyticsBreakdownDay       = "day"
+	AnalyticsBreakdownUserAgent = "user_agent"
+)
+
+// SessionEventStats 為 session 在 ClickHouse 中的事件數與最後一筆事件時間
+type SessionEventStats struct {
+	Events      int64
+	LastEventAt time.Time
+}
+
+// SessionMetric Bounces 為只有一筆事件的 session 數，Breakdown 未分組時為空字串
+type SessionMetric struct {
+	Breakdown   string
+	Sessions    int64
+	Bounces     int64
+	Events      int64
+	AvgDuration time.Duration
+	P50Duration time.Duration
+	P90Duration time.Duration
+	P99Duration time.Duration
+}
@@ -1,22 +1,50 @@
 package model
 
 import (
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// 事件日誌參照驗證失敗時的處理方式，tag 仍寫入事件日誌並記錄違反項目
+const (
+	IngestionValidationReject = "reject"
+	IngestionValidationTag    = "tag"
+)
+
 type Application struct {
-	ID          string `gorm:"primaryKey"`
-	TenantID    string `gorm:"not null"`
-	Name        string `gorm:"not null"`
-	Description string
-	CreatedAt   time.Time           `gorm:"column:created_at;not null"`
-	UpdatedAt   time.Time           `gorm:"column:updated_at;not null"`
-	DeletedAt   gorm.DeletedAt      `gorm:"column:deleted_at" sql:"index"`
-	ApiKeys     []ApplicationApiKey `gorm:"foreignKey:ApplicationID;references:ID"`
+	ID                    string `gorm:"primaryKey"`
+	TenantID              string `gorm:"not null"`
+	Name                  string `gorm:"not null"`
+	Description           string
+	AllowedOrigins        StringArray         `gorm:"column:allowed_origins;type:jsonb;not null"`
+	RateLimit             RateLimit           `gorm:"embedded;embeddedPrefix:rate_limit_"`
+	SessionTimeoutSeconds int                 `gorm:"column:session_timeout_seconds;not null;default:0"`
+	IngestionValidation   string              `gorm:"column:ingestion_validation;not null;default:reject"`
+	CreatedAt             time.Time           `gorm:"column:created_at;not null"`
+	UpdatedAt             time.Time           `gorm:"column:updated_at;not null"`
+	DeletedAt             gorm.DeletedAt      `gorm:"column:deleted_at" sql:"index"`
+	ApiKeys               []ApplicationApiKey `gorm:"foreignKey:ApplicationID;references:ID"`
 }
 
 func (Application) TableName() string {
 	return "tracking.applications"
 }
+
+// AllowsOrigin 比對來源是否在允許清單中，支援 https://*.example.com 形式的子網域萬用字元
+func (a *Application) AllowsOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, allowed := range a.AllowedOrigins {
+		allowed = strings.ToLower(allowed)
+		if allowed == origin {
+			return true
+		}
+
+		scheme, host, ok := strings.Cut(allowed, "://*.")
+		if ok && strings.HasPrefix(origin, scheme+"://") && strings.HasSuffix(origin, "."+host) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
@@ -9,12 +10,33 @@ import (
 type ApplicationApiKey struct {
 	ID            string         `gorm:"primaryKey"`
 	ApplicationID string         `gorm:"not null"`
-	APIKey        string         `gorm:"unique;not null"`
+	KeyHash       string         `gorm:"column:key_hash;unique;not null"`
+	KeyPrefix     string         `gorm:"column:key_prefix;not null"`
+	Type          string         `gorm:"column:type;not null"`
+	Scopes        StringArray    `gorm:"column:scopes;type:jsonb;not null"`
+	ExpiresAt     *time.Time     `gorm:"column:expires_at"`
+	LastUsedAt    *time.Time     `gorm:"column:last_used_at"`
+	RevokedAt     *time.Time     `gorm:"column:revoked_at"`
 	CreatedAt     time.Time      `gorm:"column:created_at;not null"`
 	UpdatedAt     time.Time      `gorm:"column:updated_at;not null"`
 	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at" sql:"index"`
+
+	// APIKey 為完整密鑰，僅在建立時填入並回傳一次，不寫入資料庫
+	APIKey string `gorm:"-"`
 }
 
 func (ApplicationApiKey) TableName() string {
 	return "tracking.applications_api_keys"
 }
+
+func (k *ApplicationApiKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// IsActive 未撤銷且未過期的密鑰才可使用
+func (k *ApplicationApiKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
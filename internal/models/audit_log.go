@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+)
+
+// 稽核紀錄的操作者類型
+const (
+	AuditActorAdminUser = "admin_user"
+	AuditActorBootstrap = "bootstrap"
+	AuditActorAPIKey    = "api_key"
+	AuditActorSystem    = "system"
+)
+
+// 稽核紀錄的操作
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+	AuditActionRotate = "rotate"
+	AuditActionRevoke = "revoke"
+)
+
+// 稽核紀錄的資源類型
+const (
+	AuditResourceTenant      = "tenant"
+	AuditResourcePlatform    = "platform"
+	AuditResourceApplication = "application"
+	AuditResourceAPIKey      = "api_key"
+	AuditResourceEvent       = "event"
+	AuditResourceEventField  = "event_field"
+)
+
+// AuditLog 為設定異動紀錄，Before 與 After 僅保留異動的欄位，新增時 Before 為空，刪除時 After 為空
+type AuditLog struct {
+	ID            string    `gorm:"primaryKey;column:id"`
+	TenantID      *string   `gorm:"column:tenant_id;index"`
+	ApplicationID *string   `gorm:"column:application_id"`
+	ActorType     string    `gorm:"column:actor_type;not null"`
+	ActorID       string    `gorm:"column:actor_id"`
+	Action        string    `gorm:"column:action;not null"`
+	ResourceType  string    `gorm:"column:resource_type;not null"`
+	ResourceID    string    `gorm:"column:resource_id;not null"`
+	Before        JSONB     `gorm:"column:before;type:jsonb"`
+	After         JSONB     `gorm:"column:after;type:jsonb"`
+	TraceID       string    `gorm:"column:trace_id"`
+	ClientIP      string    `gorm:"column:client_ip"`
+	CreatedAt     time.Time `gorm:"column:created_at;not null"`
+}
+
+func (AuditLog) TableName() string {
+	return "tracking.audit_logs"
+}
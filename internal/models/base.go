@@ -18,3 +18,20 @@ func (j *JSONB) Scan(value interface{}) error {
 	}
 	return nil
 }
+
+type StringArray []string
+
+func (a StringArray) Value() (driver.Value, error) {
+	if a == nil {
+		return "[]", nil
+	}
+	valueString, err := json.Marshal(a)
+	return string(valueString), err
+}
+
+func (a *StringArray) Scan(value interface{}) error {
+	if err := json.Unmarshal(value.([]byte), &a); err != nil {
+		return err
+	}
+	return nil
+}
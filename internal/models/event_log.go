@@ -5,13 +5,19 @@ import (
 )
 
 type EventLog struct {
-	ID            string    `gorm:"primaryKey;column:id"`
-	ApplicationID string    `gorm:"column:application_id;not null;index"`
-	SessionID     string    `gorm:"column:session_id;not null;index"`
-	EventID       string    `gorm:"column:event_id;not null;index"`
-	PlatformID    int       `gorm:"column:platform_id"`
-	Properties    JSONB     `gorm:"column:properties;type:jsonb"`
-	CreatedAt     time.Time `gorm:"column:created_at;not null;autoCreateTime"`
+	ID            string `gorm:"primaryKey;column:id"`
+	MessageID     string `gorm:"column:message_id"`
+	ApplicationID string `gorm:"column:application_id;not null;index"`
+	SessionID     string `gorm:"column:session_id;not null;index"`
+	// UserID 於寫入時取自 session，供分析計算不重複使用者
+	UserID          *string    `gorm:"column:user_id"`
+	EventID         string     `gorm:"column:event_id;not null;index"`
+	PlatformID      int        `gorm:"column:platform_id"`
+	Properties      JSONB      `gorm:"column:properties;type:jsonb"`
+	ClientTimestamp *time.Time `gorm:"column:client_timestamp"`
+	// Violations 為應用程式設定為 tag 時未通過參照驗證的錯誤代碼
+	Violations StringArray `gorm:"column:violations;type:jsonb"`
+	CreatedAt  time.Time   `gorm:"column:created_at;not null;autoCreateTime"`
 }
 
 func (EventLog) TableName() string {
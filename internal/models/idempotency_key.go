@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+)
+
+type IdempotencyKey struct {
+	ApplicationID string    `gorm:"primaryKey;column:application_id"`
+	MessageID     string    `gorm:"primaryKey;column:message_id"`
+	EventLogID    string    `gorm:"column:event_log_id;not null"`
+	EventLog      []byte    `gorm:"column:event_log;not null"`
+	ExpiresAt     time.Time `gorm:"column:expires_at;not null;index"`
+	CreatedAt     time.Time `gorm:"column:created_at;not null"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "tracking.idempotency_keys"
+}
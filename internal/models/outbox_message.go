@@ -0,0 +1,56 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+const (
+	OutboxStatusPending   = "pending"
+	OutboxStatusDelivered = "delivered"
+	OutboxStatusFailed    = "failed"
+)
+
+type OutboxMessage struct {
+	ID            string        `gorm:"primaryKey;column:id"`
+	Topic         string        `gorm:"column:topic;not null"`
+	MessageKey    string        `gorm:"column:message_key"`
+	Payload       []byte        `gorm:"column:payload;not null"`
+	Headers       OutboxHeaders `gorm:"column:headers;type:jsonb"`
+	Status        string        `gorm:"column:status;not null;index"`
+	Attempts      int           `gorm:"column:attempts;not null;default:0"`
+	NextAttemptAt time.Time     `gorm:"column:next_attempt_at;not null;index"`
+	LastError     *string       `gorm:"column:last_error"`
+	DeliveredAt   *time.Time    `gorm:"column:delivered_at"`
+	CreatedAt     time.Time     `gorm:"column:created_at;not null"`
+	UpdatedAt     time.Time     `gorm:"column:updated_at;not null"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "tracking.outbox_messages"
+}
+
+// OutboxHeader 為 Kafka 訊息標頭
+type OutboxHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// OutboxHeaders 以 [{key, value}] 陣列儲存，保留標頭順序與重複的 key
+type OutboxHeaders []OutboxHeader
+
+func (h OutboxHeaders) Value() (driver.Value, error) {
+	if h == nil {
+		return "[]", nil
+	}
+	valueString, err := json.Marshal(h)
+	return string(valueString), err
+}
+
+func (h *OutboxHeaders) Scan(value interface{}) error {
+	if err := json.Unmarshal(value.([]byte), &h); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package model
+
+// Quota 為租戶每月可接受的用量上限，0 代表不限制
+type Quota struct {
+	MonthlyEventLogs int64 `gorm:"column:monthly_event_logs;not null;default:0"`
+	MonthlySessions  int64 `gorm:"column:monthly_sessions;not null;default:0"`
+}
+
+func (q Quota) Limit(metric string) int64 {
+	switch metric {
+	case UsageMetricEventLogs:
+		return q.MonthlyEventLogs
+	case UsageMetricSessions:
+		return q.MonthlySessions
+	}
+	return 0
+}
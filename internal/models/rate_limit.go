@@ -0,0 +1,15 @@
+package model
+
+// RateLimit 為令牌桶設定，PerSecond 為 0 代表不限制，Burst 為 0 時等同 PerSecond
+type RateLimit struct {
+	PerSecond int `gorm:"column:per_second;not null;default:0"`
+	Burst     int `gorm:"column:burst;not null;default:0"`
+}
+
+func (r RateLimit) Enabled() bool {
+	return r.PerSecond > 0
+}
+
+func (r RateLimit) Capacity() int {
+	return max(r.Burst, r.PerSecond)
+}
@@ -10,6 +10,8 @@ type Tenant struct {
 	ID          string         `gorm:"primaryKey;column:id"`
 	Name        string         `gorm:"column:name;uniqueIndex"`
 	Description string         `gorm:"column:description"`
+	RateLimit   RateLimit      `gorm:"embedded;embeddedPrefix:rate_limit_"`
+	Quota       Quota          `gorm:"embedded;embeddedPrefix:quota_"`
 	CreatedAt   time.Time      `gorm:"column:created_at;not null"`
 	UpdatedAt   time.Time      `gorm:"column:updated_at;not null"`
 	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at" sql:"index"`
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+)
+
+// 用量計量項目
+const (
+	UsageMetricEventLogs = "event_logs"
+	UsageMetricSessions  = "sessions"
+)
+
+var UsageMetrics = []string{
+	UsageMetricEventLogs,
+	UsageMetricSessions,
+}
+
+// UsageDaily 為應用程式每日各計量項目的接受與拒絕數量，日期以 UTC 計算
+type UsageDaily struct {
+	ApplicationID string    `gorm:"primaryKey;column:application_id"`
+	UsageDate     time.Time `gorm:"primaryKey;column:usage_date;type:date"`
+	Metric        string    `gorm:"primaryKey;column:metric"`
+	TenantID      string    `gorm:"column:tenant_id;not null;index"`
+	Accepted      int64     `gorm:"column:accepted;not null;default:0"`
+	Rejected      int64     `gorm:"column:rejected;not null;default:0"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;not null"`
+}
+
+func (UsageDaily) TableName() string {
+	return "tracking.usage_daily"
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+	model "tracking-service/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type AdminUserRepository interface {
+	CreateAdminUser(ctx context.Context, user *model.AdminUser) error
+	GetAdminUserByID(ctx context.Context, id string) (*model.AdminUser, error)
+	GetAdminUserByEmail(ctx context.Context, email string) (*model.AdminUser, error)
+	UpdateAdminUser(ctx context.Context, user *model.AdminUser) error
+	UpdateAdminUserLastLoginAt(ctx context.Context, id string, lastLoginAt time.Time) error
+	DeleteAdminUser(ctx context.Context, user *model.AdminUser) error
+	GetAdminUsers(ctx context.Context) ([]*model.AdminUser, error)
+}
+
+type adminUserRepository struct {
+	db *gorm.DB
+}
+
+func NewAdminUserRepository(db *gorm.DB) AdminUserRepository {
+	return &adminUserRepository{
+		db: db,
+	}
+}
+
+func (r *adminUserRepository) CreateAdminUser(ctx context.Context, user *model.AdminUser) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *adminUserRepository) GetAdminUserByID(ctx context.Context, id string) (*model.AdminUser, error) {
+	var user model.AdminUser
+	err := r.db.WithContext(ctx).First(&user, "id = ?", id).Error
+	return &user, err
+}
+
+func (r *adminUserRepository) GetAdminUserByEmail(ctx context.Context, email string) (*model.AdminUser, error) {
+	var user model.AdminUser
+	err := r.db.WithContext(ctx).First(&user, "LOWER(email) = LOWER(?)", email).Error
+	return &user, err
+}
+
+func (r *adminUserRepository) UpdateAdminUser(ctx context.Context, user *model.AdminUser) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(user).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *adminUserRepository) UpdateAdminUserLastLoginAt(ctx context.Context, id string, lastLoginAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Model(&model.AdminUser{}).
+			Where("id = ?", id).
+			UpdateColumn("last_login_at", lastLoginAt).Error
+	})
+}
+
+func (r *adminUserRepository) DeleteAdminUser(ctx context.Context, user *model.AdminUser) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(user).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *adminUserRepository) GetAdminUsers(ctx context.Context) ([]*model.AdminUser, error) {
+	var users []*model.AdminUser
+	err := r.db.WithContext(ctx).Order("created_at").Find(&users).Error
+	return users, err
+}
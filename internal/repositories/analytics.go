@@ -0,0 +1,718 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	component "tracking-service/internal/components"
+	model "tracking-service/internal/models"
+	util "tracking-service/internal/utils"
+)
+
+// EventLogQuery 為 ClickHouse 事件日誌的共用篩選條件，空字串、0 與 nil 代表不篩選
+type EventLogQuery struct {
+	ApplicationID string
+	From          time.Time
+	To            time.Time
+	EventID       string
+	PlatformID    int
+	// Properties 比對屬性值，非字串的值以 JSON 表示比對
+	Properties map[string]string
+}
+
+// EventMetricsQuery Interval 為空字串時不分時間區間，BreakdownLimit 限制分組數量並保留事件數最多的分組
+type EventMetricsQuery struct {
+	EventLogQuery
+	Interval          string
+	BreakdownBy       string
+	BreakdownProperty string
+	BreakdownLimit    int
+}
+
+// EventCondition 為指定事件與其屬性值的條件
+type EventCondition struct {
+	EventID    string
+	Properties map[string]string
+}
+
+// FunnelQuery EventLogQuery 的 EventID 與 Properties 不套用，改以各步驟的條件篩選
+type FunnelQuery struct {
+	EventLogQuery
+	Steps             []*EventCondition
+	Window            time.Duration
+	CountBy           string
+	BreakdownBy       string
+	BreakdownProperty string
+	BreakdownLimit    int
+}
+
+// RetentionQuery EventLogQuery 的 EventID 與 Properties 不套用，From 與 To 為 cohort 事件的時間範圍
+// 回訪事件查詢至 To 之後 Periods 個週期
+type RetentionQuery struct {
+	EventLogQuery
+	CohortEvent *EventCondition
+	ReturnEvent *EventCondition
+	Granularity string
+	Periods     int
+	Anonymous   string
+}
+
+// PathQuery EventLogQuery 的 EventID 與 Properties 不套用，以每個 session 第一次觸發錨點事件的位置為起點
+// 沿 Direction 取至多 Depth 個事件，TopN 限制回傳的路徑數量
+type PathQuery struct {
+	EventLogQuery
+	Anchor    *EventCondition
+	Direction string
+	Depth     int
+	TopN      int
+}
+
+// SessionMetricsQuery 以開始時間在 [From, To) 內的 session 計算，PlatformID 為 0 時不篩選，BreakdownBy 為空字串時不分組
+type SessionMetricsQuery struct {
+	ApplicationID string
+	PlatformID    int
+	From          time.Time
+	To            time.Time
+	BreakdownBy   string
+}
+
+type AnalyticsRepository interface {
+	GetEventMetrics(ctx context.Context, query *EventMetricsQuery) ([]*model.EventMetric, error)
+	// GetFunnel 以 windowFunnel 計算各對象完成的步驟數，間隔時間以 window 內完成最多步驟且最早開始的一組事件計算
+	GetFunnel(ctx context.Context, query *FunnelQuery) ([]*model.FunnelResult, error)
+	// GetRetention 以第一次觸發 cohort 事件的週期分組
+	GetRetention(ctx context.Context, query *RetentionQuery) ([]*model.RetentionCohort, error)
+	// GetPaths 事件依 session 內的發生時間排序，時間相同時依 ID 排序
+	GetPaths(ctx context.Context, query *PathQuery) (*model.PathResult, error)
+	// GetSessionMetrics 以 session_id 關聯 sessions 與各 session 的事件數，回傳整體彙總與各分組的結果
+	GetSessionMetrics(ctx context.Context, query *SessionMetricsQuery) (*model.SessionMetric, []*model.SessionMetric, error)
+	// GetSessionEventStats 僅查詢 from 之後的事件，沒有事件的 session 不在回傳結果中
+	GetSessionEventStats(
+		ctx context.Context,
+		applicationID string,
+		sessionIDs []string,
+		from time.Time,
+	) (map[string]*model.SessionEventStats, error)
+}
+
+type analyticsRepository struct {
+	clickhouse *component.Clickhouse
+}
+
+func NewAnalyticsRepository(clickhouse *component.Clickhouse) AnalyticsRepository {
+	return &analyticsRepository{
+		clickhouse: clickhouse,
+	}
+}
+
+// clickhouseEventMetric 對應 GetEventMetrics 查詢的欄位
+type clickhouseEventMetric struct {
+	Bucket    int64  `json:"bucket"`
+	Breakdown string `json:"breakdown"`
+	Events    int64  `json:"events"`
+	Sessions  int64  `json:"sessions"`
+	Users     int64  `json:"users"`
+}
+
+func (r *analyticsRepository) GetEventMetrics(ctx context.Context, query *EventMetricsQuery) ([]*model.EventMetric, error) {
+	builder := newClickhouseQueryBuilder()
+	where := builder.eventLogConditions(&query.EventLogQuery)
+
+	bucket := "0"
+	if query.Interval != "" {
+		bucket = fmt.Sprintf("toUnixTimestamp(%s(created_at))", clickhouseIntervalFunction(query.Interval))
+	}
+
+	breakdown := "''"
+	switch query.BreakdownBy {
+	case model.AnalyticsBreakdownPlatform:
+		breakdown = "toString(platform_id)"
+	case model.AnalyticsBreakdownProperty:
+		breakdown = builder.propertyExpression(query.BreakdownProperty)
+	}
+	if query.BreakdownBy != "" && query.BreakdownLimit > 0 {
+		where += fmt.Sprintf(
+			" AND %s IN (SELECT %s FROM event_logs WHERE %s GROUP BY 1 ORDER BY count() DESC LIMIT %d)",
+			breakdown, breakdown, where, query.BreakdownLimit,
+		)
+	}
+
+	sql := fmt.Sprintf(`SELECT
+	%s AS bucket,
+	%s AS breakdown,
+	count() AS events,
+	uniqExact(session_id) AS sessions,
+	uniqExactIf(user_id, user_id != '') AS users
+FROM event_logs
+WHERE %s
+GROUP BY bucket, breakdown
+ORDER BY bucket, breakdown`, bucket, breakdown, where)
+
+	rows, err := r.clickhouse.Query(ctx, sql, builder.params)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]*model.EventMetric, 0, len(rows))
+	for _, row := range rows {
+		var metric clickhouseEventMetric
+		if err := json.Unmarshal(row, &metric); err != nil {
+			return nil, fmt.Errorf("decode clickhouse event metric failed: %w", err)
+		}
+
+		var bucketAt *time.Time
+		if query.Interval != "" {
+			t := time.Unix(metric.Bucket, 0).UTC()
+			bucketAt = &t
+		}
+		metrics = append(metrics, &model.EventMetric{
+			Bucket:    bucketAt,
+			Breakdown: metric.Breakdown,
+			Events:    metric.Events,
+			Sessions:  metric.Sessions,
+			Users:     metric.Users,
+		})
+	}
+	return metrics, nil
+}
+
+// clickhouseFunnel 對應 GetFunnel 查詢的欄位，medians 為與前一步驟間隔的毫秒數
+type clickhouseFunnel struct {
+	Breakdown string  `json:"breakdown"`
+	Counts    []int64 `json:"counts"`
+	Medians   []int64 `json:"medians"`
+}
+
+func (r *analyticsRepository) GetFunnel(ctx context.Context, query *FunnelQuery) ([]*model.FunnelResult, error) {
+	builder := newClickhouseQueryBuilder()
+	baseQuery := query.EventLogQuery
+	baseQuery.EventID = ""
+	baseQuery.Properties = nil
+	where := builder.eventLogConditions(&baseQuery)
+
+	actor := "session_id"
+	if query.CountBy == model.AnalyticsCountByUser {
+		actor = "user_id"
+		where += " AND user_id != ''"
+	}
+
+	eventIDs := make([]string, 0, len(query.Steps))
+	stepConditions := make([]string, 0, len(query.Steps))
+	for _, step := range query.Steps {
+		eventID := builder.param("String", step.EventID)
+		eventIDs = append(eventIDs, eventID)
+		stepConditions = append(stepConditions, builder.eventCondition(eventID, step))
+	}
+	where += fmt.Sprintf(" AND event_id IN (%s)", strings.Join(eventIDs, ", "))
+
+	// 分組取第一步發生時的平台或屬性
+	breakdown := "''"
+	switch query.BreakdownBy {
+	case model.AnalyticsBreakdownPlatform:
+		breakdown = fmt.Sprintf("toString(argMinIf(platform_id, ts, %s))", stepConditions[0])
+	case model.AnalyticsBreakdownProperty:
+		breakdown = fmt.Sprintf("argMinIf(%s, ts, %s)", builder.propertyExpression(query.BreakdownProperty), stepConditions[0])
+	}
+
+	// 以每次完成第一步為起點，依序取 window 內下一步最早的事件，選完成最多步驟且最早的起點計算間隔
+	window := query.Window.Milliseconds()
+	stepTimestamps := make([]string, 0, len(query.Steps))
+	chains := make([]string, 0, len(query.Steps))
+	chainLevels := make([]string, 0, len(query.Steps))
+	chainTimestamps := make([]string, 0, len(query.Steps))
+	counts := make([]string, 0, len(query.Steps))
+	medians := make([]string, 0, len(query.Steps))
+	for i, condition := range stepConditions {
+		step := i + 1
+		stepTimestamps = append(stepTimestamps, fmt.Sprintf("arraySort(groupArrayIf(ts, %s)) AS ts_%d", condition, step))
+		chainLevels = append(chainLevels, fmt.Sprintf("(chain_%d[j] > 0)", step))
+		chainTimestamps = append(chainTimestamps, fmt.Sprintf("chain_%d[best] AS t_%d", step, step))
+		counts = append(counts, fmt.Sprintf("countIf(level >= %d)", step))
+		if i == 0 {
+			chains = append(chains, "ts_1 AS chain_1")
+			medians = append(medians, "0")
+			continue
+		}
+		chains = append(chains, fmt.Sprintf(
+			"arrayMap((start, prev) -> if(prev = 0, 0, arrayFirst(x -> x >= prev AND x <= start + %d, ts_%d)), ts_1, chain_%d) AS chain_%d",
+			window, step, i, step,
+		))
+		medians = append(medians, fmt.Sprintf(
+			"toInt64(quantileExactIf(0.5)(toInt64(t_%d) - toInt64(t_%d), level >= %d AND t_%d > 0))", step, i, step, step,
+		))
+	}
+
+	// 沒有完成第一步的對象不計入，避免產生空的分組
+	sql := fmt.Sprintf(`SELECT
+	breakdown,
+	[%s] AS counts,
+	[%s] AS medians
+FROM (
+	SELECT
+		breakdown,
+		level,
+		%s,
+		arrayMap(j -> %s, arrayEnumerate(ts_1)) AS chain_levels,
+		indexOf(chain_levels, arrayMax(chain_levels)) AS best,
+		%s
+	FROM (
+		SELECT
+			%s AS actor,
+			%s AS breakdown,
+			windowFunnel(%d)(ts, %s) AS level,
+			%s
+		FROM (SELECT *, toUInt64(toUnixTimestamp64Milli(created_at)) AS ts FROM event_logs WHERE %s)
+		GROUP BY actor
+	)
+	WHERE level > 0
+)
+GROUP BY breakdown
+ORDER BY counts[1] DESC, breakdown
+LIMIT %d`,
+		strings.Join(counts, ", "),
+		strings.Join(medians, ", "),
+		strings.Join(chains, ",\n\t\t"),
+		strings.Join(chainLevels, " + "),
+		strings.Join(chainTimestamps, ", "),
+		actor,
+		breakdown,
+		window, strings.Join(stepConditions, ", "),
+		strings.Join(stepTimestamps, ",\n\t\t\t"),
+		where,
+		query.BreakdownLimit,
+	)
+
+	rows, err := r.clickhouse.Query(ctx, sql, builder.params)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.FunnelResult, 0, len(rows))
+	for _, row := range rows {
+		var funnel clickhouseFunnel
+		if err := json.Unmarshal(row, &funnel); err != nil {
+			return nil, fmt.Errorf("decode clickhouse funnel failed: %w", err)
+		}
+
+		result := &model.FunnelResult{
+			Breakdown: funnel.Breakdown,
+			Steps:     make([]*model.FunnelStepResult, 0, len(funnel.Counts)),
+		}
+		for i, count := range funnel.Counts {
+			step := &model.FunnelStepResult{Count: count}
+			if i > 0 && count > 0 && i < len(funnel.Medians) {
+				median := time.Duration(funnel.Medians[i]) * time.Millisecond
+				step.MedianFromPrevious = &median
+			}
+			result.Steps = append(result.Steps, step)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// clickhouseRetentionCohort 對應 GetRetention 查詢的欄位
+type clickhouseRetentionCohort struct {
+	Cohort   int64   `json:"cohort"`
+	Size     int64   `json:"size"`
+	Retained []int64 `json:"retained"`
+}
+
+func (r *analyticsRepository) GetRetention(ctx context.Context, query *RetentionQuery) ([]*model.RetentionCohort, error) {
+	builder := newClickhouseQueryBuilder()
+
+	// 匿名 session 以 session_id 計算，session 與 session_key 為一對一
+	actor := "user_id"
+	anonymous := " AND user_id != ''"
+	if query.Anonymous == model.AnalyticsAnonymousSessionKey {
+		actor = "if(user_id != '', user_id, concat('session:', session_id))"
+		anonymous = ""
+	}
+
+	period, offset := "toStartOfDay(created_at)", "dateDiff('day', c.cohort_period, r.return_period)"
+	periodDuration := 24 * time.Hour
+	if query.Granularity == model.AnalyticsGranularityWeek {
+		period, offset = "toDateTime(toMonday(created_at), 'UTC')", "intDiv(dateDiff('day', c.cohort_period, r.return_period), 7)"
+		periodDuration = 7 * 24 * time.Hour
+	}
+
+	cohortQuery := query.EventLogQuery
+	cohortQuery.EventID = ""
+	cohortQuery.Properties = nil
+	cohortWhere := builder.eventLogConditions(&cohortQuery) + anonymous + " AND " +
+		builder.eventCondition(builder.param("String", query.CohortEvent.EventID), query.CohortEvent)
+
+	returnQuery := cohortQuery
+	returnQuery.To = query.To.Add(time.Duration(query.Periods) * periodDuration)
+	returnWhere := builder.eventLogConditions(&returnQuery) + anonymous + " AND " +
+		builder.eventCondition(builder.param("String", query.ReturnEvent.EventID), query.ReturnEvent)
+
+	sql := fmt.Sprintf(`SELECT
+	toUnixTimestamp(cohort_period) AS cohort,
+	count() AS size,
+	sumForEach(arrayMap(k -> toUInt64(has(offsets, k)), range(%d))) AS retained
+FROM (
+	SELECT
+		c.actor AS actor,
+		c.cohort_period AS cohort_period,
+		groupArrayIf(%s, r.return_period >= c.cohort_period) AS offsets
+	FROM (
+		SELECT %s AS actor, min(%s) AS cohort_period
+		FROM event_logs
+		WHERE %s
+		GROUP BY actor
+	) AS c
+	LEFT JOIN (
+		SELECT DISTINCT %s AS actor, %s AS return_period
+		FROM event_logs
+		WHERE %s
+	) AS r ON c.actor = r.actor
+	GROUP BY c.actor, c.cohort_period
+)
+GROUP BY cohort_period
+ORDER BY cohort_period`,
+		query.Periods+1,
+		offset,
+		actor, period, cohortWhere,
+		actor, period, returnWhere,
+	)
+
+	rows, err := r.clickhouse.Query(ctx, sql, builder.params)
+	if err != nil {
+		return nil, err
+	}
+
+	cohorts := make([]*model.RetentionCohort, 0, len(rows))
+	for _, row := range rows {
+		var cohort clickhouseRetentionCohort
+		if err := json.Unmarshal(row, &cohort); err != nil {
+			return nil, fmt.Errorf("decode clickhouse retention cohort failed: %w", err)
+		}
+		cohorts = append(cohorts, &model.RetentionCohort{
+			Cohort:   time.Unix(cohort.Cohort, 0).UTC(),
+			Size:     cohort.Size,
+			Retained: cohort.Retained,
+		})
+	}
+	return cohorts, nil
+}
+
+// clickhousePath 對應 GetPaths 查詢的欄位，total 為觸發錨點事件的 session 數
+type clickhousePath struct {
+	Path     []string `json:"path"`
+	Sessions int64    `json:"sessions"`
+	Total    int64    `json:"total"`
+}
+
+func (r *analyticsRepository) GetPaths(ctx context.Context, query *PathQuery) (*model.PathResult, error) {
+	builder := newClickhouseQueryBuilder()
+	baseQuery := query.EventLogQuery
+	baseQuery.EventID = ""
+	baseQuery.Properties = nil
+	where := builder.eventLogConditions(&baseQuery)
+	anchor := builder.eventCondition(builder.param("String", query.Anchor.EventID), query.Anchor)
+
+	path := "arraySlice(events, anchor_index, %d)"
+	if query.Direction == model.AnalyticsDirectionBefore {
+		path = "arraySlice(arrayReverse(events), length(events) - anchor_index + 1, %d)"
+	}
+	path = fmt.Sprintf(path, query.Depth+1)
+
+	sql := fmt.Sprintf(`SELECT
+	path,
+	count() AS sessions,
+	sum(count()) OVER () AS total
+FROM (
+	SELECT %s AS path
+	FROM (
+		SELECT
+			arrayMap(x -> x.3, sequence) AS events,
+			arrayFirstIndex(x -> x.4, sequence) AS anchor_index
+		FROM (
+			SELECT arraySort(x -> (x.1, x.2), groupArray((created_at, id, event_id, %s))) AS sequence
+			FROM event_logs
+			WHERE %s
+			GROUP BY session_id
+			HAVING countIf(%s) > 0
+		)
+	)
+)
+GROUP BY path
+ORDER BY sessions DESC, path
+LIMIT %d`, path, anchor, where, anchor, query.TopN)
+
+	rows, err := r.clickhouse.Query(ctx, sql, builder.params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.PathResult{
+		Paths: make([]*model.EventPath, 0, len(rows)),
+	}
+	for _, row := range rows {
+		var path clickhousePath
+		if err := json.Unmarshal(row, &path); err != nil {
+			return nil, fmt.Errorf("decode clickhouse path failed: %w", err)
+		}
+		result.Sessions = path.Total
+		result.Paths = append(result.Paths, &model.EventPath{
+			Events:   path.Path,
+			Sessions: path.Sessions,
+		})
+	}
+	return result, nil
+}
+
+// clickhouseSessionMetric 對應 GetSessionMetrics 查詢的欄位，時長皆為毫秒，percentiles 依序為 p50、p90、p99
+type clickhouseSessionMetric struct {
+	IsTotal     int     `json:"is_total"`
+	Breakdown   string  `json:"breakdown"`
+	Sessions    int64   `json:"sessions"`
+	Bounces     int64   `json:"bounces"`
+	Events      int64   `json:"events"`
+	AvgDuration int64   `json:"avg_duration"`
+	Percentiles []int64 `json:"percentiles"`
+}
+
+// GetSessionMetrics 已結束的 session 以 ended_at 計算時長，尚未結束的以最後一筆事件時間推算，沒有事件時時長為 0
+// 每個 session 以 ARRAY JOIN 同時計入整體與所屬分組，百分位數以 nearest-rank 計算
+func (r *analyticsRepository) GetSessionMetrics(
+	ctx context.Context,
+	query *SessionMetricsQuery,
+) (*model.SessionMetric, []*model.SessionMetric, error) {
+	builder := newClickhouseQueryBuilder()
+	applicationID := builder.param("String", query.ApplicationID)
+	from := builder.param("DateTime64(3, 'UTC')", query.From.UTC().Format(clickhouseDateTimeLayout))
+	conditions := []string{
+		"s.application_id = " + applicationID,
+		"s.started_at >= " + from,
+		"s.started_at < " + builder.param("DateTime64(3, 'UTC')", query.To.UTC().Format(clickhouseDateTimeLayout)),
+		"s.deleted_at IS NULL",
+	}
+	if query.PlatformID != 0 {
+		conditions = append(conditions, "s.platform_id = "+builder.param("Int32", strconv.Itoa(query.PlatformID)))
+	}
+
+	groupingKeys := "[(1, '')]"
+	if query.BreakdownBy != "" {
+		groupingKeys = "[(1, ''), (0, breakdown_value)]"
+	}
+
+	sql := fmt.Sprintf(`SELECT
+	is_total,
+	breakdown,
+	sessions,
+	bounces,
+	events,
+	avg_duration,
+	arrayMap(p -> durations[toUInt64(ceil(p * length(durations)))], [0.5, 0.9, 0.99]) AS percentiles
+FROM (
+	SELECT
+		grouping_key.1 AS is_total,
+		grouping_key.2 AS breakdown,
+		count() AS sessions,
+		countIf(events = 1) AS bounces,
+		sum(events) AS events,
+		intDiv(sum(duration), count()) AS avg_duration,
+		arraySort(groupArray(duration)) AS durations
+	FROM (
+		SELECT
+			%s AS breakdown_value,
+			stats.events AS events,
+			greatest(multiIf(
+				s.ended_at IS NOT NULL, dateDiff('millisecond', s.started_at, assumeNotNull(s.ended_at)),
+				stats.events > 0, dateDiff('millisecond', s.started_at, stats.last_event_at),
+				0
+			), 0) AS duration
+		FROM sessions AS s
+		LEFT JOIN (
+			SELECT session_id, count() AS events, max(created_at) AS last_event_at
+			FROM event_logs
+			WHERE application_id = %s AND created_at >= %s
+			GROUP BY session_id
+		) AS stats ON stats.session_id = s.id
+		WHERE %s
+	)
+	ARRAY JOIN %s AS grouping_key
+	GROUP BY grouping_key
+)`, builder.sessionBreakdown(query.BreakdownBy), applicationID, from, strings.Join(conditions, " AND "), groupingKeys)
+
+	rows, err := r.clickhouse.Query(ctx, sql, builder.params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totals := &model.SessionMetric{}
+	breakdowns := make([]*model.SessionMetric, 0, len(rows))
+	for _, row := range rows {
+		var metric clickhouseSessionMetric
+		if err := json.Unmarshal(row, &metric); err != nil {
+			return nil, nil, fmt.Errorf("decode clickhouse session metric failed: %w", err)
+		}
+
+		result := &model.SessionMetric{
+			Breakdown:   metric.Breakdown,
+			Sessions:    metric.Sessions,
+			Bounces:     metric.Bounces,
+			Events:      metric.Events,
+			AvgDuration: time.Duration(metric.AvgDuration) * time.Millisecond,
+		}
+		if len(metric.Percentiles) == 3 {
+			result.P50Duration = time.Duration(metric.Percentiles[0]) * time.Millisecond
+			result.P90Duration = time.Duration(metric.Percentiles[1]) * time.Millisecond
+			result.P99Duration = time.Duration(metric.Percentiles[2]) * time.Millisecond
+		}
+		if metric.IsTotal == 1 {
+			totals = result
+		} else {
+			breakdowns = append(breakdowns, result)
+		}
+	}
+	return totals, breakdowns, nil
+}
+
+// clickhouseSessionEventStats 對應 GetSessionEventStats 查詢的欄位，last_event_at 為 Unix 毫秒數
+type clickhouseSessionEventStats struct {
+	SessionID   string `json:"session_id"`
+	Events      int64  `json:"events"`
+	LastEventAt int64  `json:"last_event_at"`
+}
+
+func (r *analyticsRepository) GetSessionEventStats(
+	ctx context.Context,
+	applicationID string,
+	sessionIDs []string,
+	from time.Time,
+) (map[string]*model.SessionEventStats, error) {
+	builder := newClickhouseQueryBuilder()
+	sql := fmt.Sprintf(`SELECT
+	session_id,
+	count() AS events,
+	toUnixTimestamp64Milli(max(created_at)) AS last_event_at
+FROM event_logs
+WHERE application_id = %s AND created_at >= %s AND session_id IN %s
+GROUP BY session_id`,
+		builder.param("String", applicationID),
+		builder.param("DateTime64(3, 'UTC')", from.UTC().Format(clickhouseDateTimeLayout)),
+		builder.param("Array(String)", clickhouseStringArray(sessionIDs)),
+	)
+
+	rows, err := r.clickhouse.Query(ctx, sql, builder.params)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]*model.SessionEventStats, len(rows))
+	for _, row := range rows {
+		var stat clickhouseSessionEventStats
+		if err := json.Unmarshal(row, &stat); err != nil {
+			return nil, fmt.Errorf("decode clickhouse session event stats failed: %w", err)
+		}
+		stats[stat.SessionID] = &model.SessionEventStats{
+			Events:      stat.Events,
+			LastEventAt: time.UnixMilli(stat.LastEventAt).UTC(),
+		}
+	}
+	return stats, nil
+}
+
+// clickhouseQueryBuilder 收集查詢條件與具名參數，使用者輸入一律以參數帶入
+type clickhouseQueryBuilder struct {
+	params map[string]string
+}
+
+func newClickhouseQueryBuilder() *clickhouseQueryBuilder {
+	return &clickhouseQueryBuilder{
+		params: make(map[string]string),
+	}
+}
+
+// param 登錄參數並回傳 {name:Type} 佔位字串
+func (b *clickhouseQueryBuilder) param(dataType string, value string) string {
+	name := "p" + strconv.Itoa(len(b.params))
+	b.params[name] = value
+	return fmt.Sprintf("{%s:%s}", name, dataType)
+}
+
+// propertyExpression 取得屬性值，字串直接取值，其他型別取 JSON 表示
+func (b *clickhouseQueryBuilder) propertyExpression(key string) string {
+	name := b.param("String", key)
+	return fmt.Sprintf(
+		"if(JSONType(properties, %s) = 'String', JSONExtractString(properties, %s), JSONExtractRaw(properties, %s))",
+		name, name, name,
+	)
+}
+
+// eventCondition eventID 為已登錄的參數佔位字串
+func (b *clickhouseQueryBuilder) eventCondition(eventID string, condition *EventCondition) string {
+	expression := "event_id = " + eventID
+	for key, value := range condition.Properties {
+		expression += " AND " + b.propertyExpression(key) + " = " + b.param("String", value)
+	}
+	return "(" + expression + ")"
+}
+
+// sessionBreakdown 日期以 UTC 當日起點的 Unix 秒數表示，瀏覽器家族與 util.UserAgentFamily 的判斷相同
+func (b *clickhouseQueryBuilder) sessionBreakdown(breakdownBy string) string {
+	switch breakdownBy {
+	case model.AnalyticsBreakdownPlatform:
+		return "toString(s.platform_id)"
+	case model.AnalyticsBreakdownDay:
+		return "toString(toUnixTimestamp(toStartOfDay(s.started_at, 'UTC')))"
+	case model.AnalyticsBreakdownUserAgent:
+		branches := []string{"ifNull(s.user_agent, '') = ''", b.param("String", "Unknown")}
+		for _, candidate := range util.UserAgentFamilies {
+			branches = append(branches,
+				"multiSearchAny(lower(assumeNotNull(s.user_agent)), "+b.param("Array(String)", clickhouseStringArray(candidate.Markers))+")",
+				b.param("String", candidate.Family),
+			)
+		}
+		branches = append(branches, b.param("String", "Other"))
+		return "multiIf(" + strings.Join(branches, ", ") + ")"
+	default:
+		return "''"
+	}
+}
+
+func (b *clickhouseQueryBuilder) eventLogConditions(query *EventLogQuery) string {
+	conditions := []string{
+		"application_id = " + b.param("String", query.ApplicationID),
+		"created_at >= " + b.param("DateTime64(3, 'UTC')", query.From.UTC().Format(clickhouseDateTimeLayout)),
+		"created_at < " + b.param("DateTime64(3, 'UTC')", query.To.UTC().Format(clickhouseDateTimeLayout)),
+	}
+	if query.EventID != "" {
+		conditions = append(conditions, "event_id = "+b.param("String", query.EventID))
+	}
+	if query.PlatformID != 0 {
+		conditions = append(conditions, "platform_id = "+b.param("Int32", strconv.Itoa(query.PlatformID)))
+	}
+	for key, value := range query.Properties {
+		conditions = append(conditions, b.propertyExpression(key)+" = "+b.param("String", value))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+var clickhouseStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// clickhouseStringArray 將字串切片轉為 Array(String) 參數的文字表示
+func clickhouseStringArray(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "'"+clickhouseStringEscaper.Replace(value)+"'")
+	}
+	return "[" + strings.Join(quoted, ",") + "]"
+}
+
+func clickhouseIntervalFunction(interval string) string {
+	switch interval {
+	case model.AnalyticsIntervalMinute:
+		return "toStartOfMinute"
+	case model.AnalyticsIntervalDay:
+		return "toStartOfDay"
+	default:
+		return "toStartOfHour"
+	}
+}
@@ -0,0 +1,353 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	component "tracking-service/internal/components"
+	model "tracking-service/internal/models"
+)
+
+// fakeClickhouse 記錄收到的查詢與參數，回傳預先設定的 JSONEachRow 資料列
+type fakeClickhouse struct {
+	query  string
+	params map[string]string
+}
+
+func newFakeClickhouse(t *testing.T, rows ...string) (*fakeClickhouse, *component.Clickhouse) {
+	t.Helper()
+	fake := &fakeClickhouse{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fake.query = string(body)
+		fake.params = make(map[string]string)
+		for name, values := range r.URL.Query() {
+			if strings.HasPrefix(name, "param_") {
+				fake.params[strings.TrimPrefix(name, "param_")] = values[0]
+			}
+		}
+		fmt.Fprint(w, strings.Join(rows, "\n"))
+	}))
+	t.Cleanup(server.Close)
+	return fake, component.NewClickhouse(&shared.Config{ClickhouseEndpoint: server.URL})
+}
+
+// hasParam 確認查詢以參數帶入 value，而非直接寫入 SQL
+func (f *fakeClickhouse) hasParam(value string) bool {
+	for _, v := range f.params {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestClickhouseQueryBuilderEventCondition(t *testing.T) {
+	b := newClickhouseQueryBuilder()
+	eventID := b.param("String", "purchase")
+	got := b.eventCondition(eventID, &EventCondition{EventID: "purchase", Properties: map[string]string{"plan": "pro"}})
+
+	want := "(event_id = {p0:String} AND if(JSONType(properties, {p1:String}) = 'String', JSONExtractString(properties, {p1:String}), JSONExtractRaw(properties, {p1:String})) = {p2:String})"
+	if got != want {
+		t.Errorf("eventCondition() =\n%s\nwant\n%s", got, want)
+	}
+	if b.params["p0"] != "purchase" || b.params["p1"] != "plan" || b.params["p2"] != "pro" {
+		t.Errorf("params = %v", b.params)
+	}
+}
+
+func TestClickhouseQueryBuilderEventLogConditions(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		query      *EventLogQuery
+		want       string
+		wantParams map[string]string
+	}{
+		{
+			name:  "time range only",
+			query: &EventLogQuery{ApplicationID: "app-1", From: from, To: from.Add(time.Hour)},
+			want:  "application_id = {p0:String} AND created_at >= {p1:DateTime64(3, 'UTC')} AND created_at < {p2:DateTime64(3, 'UTC')}",
+			wantParams: map[string]string{
+				"p0": "app-1",
+				"p1": "2024-01-01 00:00:00.000",
+				"p2": "2024-01-01 01:00:00.000",
+			},
+		},
+		{
+			name:  "event and platform",
+			query: &EventLogQuery{ApplicationID: "app-1", From: from, To: from.Add(time.Hour), EventID: "signup", PlatformID: 2},
+			want:  "application_id = {p0:String} AND created_at >= {p1:DateTime64(3, 'UTC')} AND created_at < {p2:DateTime64(3, 'UTC')} AND event_id = {p3:String} AND platform_id = {p4:Int32}",
+			wantParams: map[string]string{
+				"p0": "app-1",
+				"p1": "2024-01-01 00:00:00.000",
+				"p2": "2024-01-01 01:00:00.000",
+				"p3": "signup",
+				"p4": "2",
+			},
+		},
+		{
+			name:  "non utc time converted",
+			query: &EventLogQuery{ApplicationID: "app-1", From: from.In(time.FixedZone("UTC+8", 8*3600)), To: from.Add(time.Hour)},
+			want:  "application_id = {p0:String} AND created_at >= {p1:DateTime64(3, 'UTC')} AND created_at < {p2:DateTime64(3, 'UTC')}",
+			wantParams: map[string]string{
+				"p0": "app-1",
+				"p1": "2024-01-01 00:00:00.000",
+				"p2": "2024-01-01 01:00:00.000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newClickhouseQueryBuilder()
+			if got := b.eventLogConditions(tt.query); got != tt.want {
+				t.Errorf("eventLogConditions() =\n%s\nwant\n%s", got, tt.want)
+			}
+			for name, value := range tt.wantParams {
+				if b.params[name] != value {
+					t.Errorf("param %s = %q, want %q", name, b.params[name], value)
+				}
+			}
+		})
+	}
+}
+
+func TestClickhouseStringArray(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{values: nil, want: "[]"},
+		{values: []string{"chrome", "crios"}, want: "['chrome','crios']"},
+		{values: []string{`it's`, `back\slash`}, want: `['it\'s','back\\slash']`},
+	}
+
+	for _, tt := range tests {
+		if got := clickhouseStringArray(tt.values); got != tt.want {
+			t.Errorf("clickhouseStringArray(%q) = %s, want %s", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestAnalyticsRepositoryGetFunnelQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		query       *FunnelQuery
+		contains    []string
+		notContains []string
+		params      []string
+	}{
+		{
+			name: "window applied to every later step",
+			query: &FunnelQuery{
+				EventLogQuery:  EventLogQuery{ApplicationID: "app-1", From: from, To: from.Add(24 * time.Hour)},
+				Steps:          []*EventCondition{{EventID: "view"}, {EventID: "cart"}, {EventID: "purchase"}},
+				Window:         30 * time.Minute,
+				BreakdownLimit: 10,
+			},
+			contains: []string{
+				"windowFunnel(1800000)(ts, ",
+				"ts_1 AS chain_1",
+				"arrayFirst(x -> x >= prev AND x <= start + 1800000, ts_2)), ts_1, chain_1) AS chain_2",
+				"arrayFirst(x -> x >= prev AND x <= start + 1800000, ts_3)), ts_1, chain_2) AS chain_3",
+				"arrayMap(j -> (chain_1[j] > 0) + (chain_2[j] > 0) + (chain_3[j] > 0), arrayEnumerate(ts_1)) AS chain_levels",
+				"indexOf(chain_levels, arrayMax(chain_levels)) AS best",
+				"chain_1[best] AS t_1, chain_2[best] AS t_2, chain_3[best] AS t_3",
+				"WHERE level > 0",
+				"[countIf(level >= 1), countIf(level >= 2), countIf(level >= 3)] AS counts",
+				"quantileExactIf(0.5)(toInt64(t_3) - toInt64(t_2), level >= 3 AND t_3 > 0)",
+				"session_id AS actor",
+				"LIMIT 10",
+			},
+			notContains: []string{"user_id != ''"},
+			params:      []string{"app-1", "view", "cart", "purchase"},
+		},
+		{
+			name: "count by user skips anonymous events",
+			query: &FunnelQuery{
+				EventLogQuery: EventLogQuery{ApplicationID: "app-1", From: from, To: from.Add(24 * time.Hour)},
+				Steps:         []*EventCondition{{EventID: "view"}, {EventID: "purchase"}},
+				Window:        time.Hour,
+				CountBy:       model.AnalyticsCountByUser,
+			},
+			contains: []string{
+				"windowFunnel(3600000)(ts, ",
+				"user_id AS actor",
+				"AND user_id != ''",
+			},
+			params: []string{"view", "purchase"},
+		},
+		{
+			name: "breakdown by first step property",
+			query: &FunnelQuery{
+				EventLogQuery:     EventLogQuery{ApplicationID: "app-1", From: from, To: from.Add(24 * time.Hour)},
+				Steps:             []*EventCondition{{EventID: "view", Properties: map[string]string{"source": "ads"}}, {EventID: "purchase"}},
+				Window:            time.Hour,
+				BreakdownBy:       model.AnalyticsBreakdownProperty,
+				BreakdownProperty: "campaign",
+			},
+			contains: []string{"argMinIf(if(JSONType(properties, "},
+			params:   []string{"source", "ads", "campaign"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, clickhouse := newFakeClickhouse(t)
+			repo := NewAnalyticsRepository(clickhouse)
+			if _, err := repo.GetFunnel(context.Background(), tt.query); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, s := range tt.contains {
+				if !strings.Contains(fake.query, s) {
+					t.Errorf("query missing %q:\n%s", s, fake.query)
+				}
+			}
+			for _, s := range tt.notContains {
+				if strings.Contains(fake.query, s) {
+					t.Errorf("query contains %q:\n%s", s, fake.query)
+				}
+			}
+			for _, value := range tt.params {
+				if !fake.hasParam(value) {
+					t.Errorf("params %v missing %q", fake.params, value)
+				}
+				if strings.Contains(fake.query, "'"+value+"'") {
+					t.Errorf("value %q inlined into query", value)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyticsRepositoryGetFunnelResult(t *testing.T) {
+	_, clickhouse := newFakeClickhouse(t,
+		`{"breakdown":"","counts":[10,4,0],"medians":[0,1500,0]}`,
+	)
+	repo := NewAnalyticsRepository(clickhouse)
+
+	results, err := repo.GetFunnel(context.Background(), &FunnelQuery{
+		EventLogQuery: EventLogQuery{ApplicationID: "app-1", From: time.Now().Add(-time.Hour), To: time.Now()},
+		Steps:         []*EventCondition{{EventID: "view"}, {EventID: "cart"}, {EventID: "purchase"}},
+		Window:        time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || len(results[0].Steps) != 3 {
+		t.Fatalf("results = %+v, want one result with 3 steps", results)
+	}
+
+	tests := []struct {
+		step       int
+		wantCount  int64
+		wantMedian *time.Duration
+	}{
+		{step: 0, wantCount: 10},
+		{step: 1, wantCount: 4, wantMedian: durationPtr(1500 * time.Millisecond)},
+		// 無人完成的步驟沒有間隔時間
+		{step: 2, wantCount: 0},
+	}
+	for _, tt := range tests {
+		got := results[0].Steps[tt.step]
+		if got.Count != tt.wantCount {
+			t.Errorf("step %d count = %d, want %d", tt.step, got.Count, tt.wantCount)
+		}
+		switch {
+		case tt.wantMedian == nil && got.MedianFromPrevious != nil:
+			t.Errorf("step %d median = %v, want nil", tt.step, *got.MedianFromPrevious)
+		case tt.wantMedian != nil && (got.MedianFromPrevious == nil || *got.MedianFromPrevious != *tt.wantMedian):
+			t.Errorf("step %d median = %v, want %v", tt.step, got.MedianFromPrevious, *tt.wantMedian)
+		}
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestAnalyticsRepositoryGetPathsQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		direction string
+		depth     int
+		wantPath  string
+	}{
+		{name: "after anchor", direction: model.AnalyticsDirectionAfter, depth: 3, wantPath: "arraySlice(events, anchor_index, 4) AS path"},
+		{name: "before anchor", direction: model.AnalyticsDirectionBefore, depth: 2, wantPath: "arraySlice(arrayReverse(events), length(events) - anchor_index + 1, 3) AS path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, clickhouse := newFakeClickhouse(t)
+			repo := NewAnalyticsRepository(clickhouse)
+			_, err := repo.GetPaths(context.Background(), &PathQuery{
+				EventLogQuery: EventLogQuery{ApplicationID: "app-1", From: from, To: from.Add(24 * time.Hour), EventID: "ignored"},
+				Anchor:        &EventCondition{EventID: "signup", Properties: map[string]string{"plan": "pro"}},
+				Direction:     tt.direction,
+				Depth:         tt.depth,
+				TopN:          20,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, s := range []string{
+				tt.wantPath,
+				"arraySort(x -> (x.1, x.2), groupArray((created_at, id, event_id, (event_id = {p3:String} AND ",
+				"arrayFirstIndex(x -> x.4, sequence) AS anchor_index",
+				"GROUP BY session_id",
+				"HAVING countIf((event_id = {p3:String} AND ",
+				"LIMIT 20",
+			} {
+				if !strings.Contains(fake.query, s) {
+					t.Errorf("query missing %q:\n%s", s, fake.query)
+				}
+			}
+			// 路徑分析以錨點事件篩選，不套用 EventLogQuery 的 EventID
+			if fake.hasParam("ignored") {
+				t.Errorf("params %v contain the base event id", fake.params)
+			}
+			for _, value := range []string{"signup", "plan", "pro"} {
+				if !fake.hasParam(value) {
+					t.Errorf("params %v missing %q", fake.params, value)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyticsRepositoryGetPathsResult(t *testing.T) {
+	_, clickhouse := newFakeClickhouse(t,
+		`{"path":["signup","onboarding","purchase"],"sessions":6,"total":10}`,
+		`{"path":["signup"],"sessions":4,"total":10}`,
+	)
+	repo := NewAnalyticsRepository(clickhouse)
+
+	result, err := repo.GetPaths(context.Background(), &PathQuery{
+		EventLogQuery: EventLogQuery{ApplicationID: "app-1", From: time.Now().Add(-time.Hour), To: time.Now()},
+		Anchor:        &EventCondition{EventID: "signup"},
+		Direction:     model.AnalyticsDirectionAfter,
+		Depth:         2,
+		TopN:          10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Sessions != 10 {
+		t.Errorf("sessions = %d, want 10", result.Sessions)
+	}
+	if len(result.Paths) != 2 || len(result.Paths[0].Events) != 3 || result.Paths[0].Sessions != 6 || result.Paths[1].Sessions != 4 {
+		t.Errorf("paths = %+v", result.Paths)
+	}
+}
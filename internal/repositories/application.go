@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"strings"
+	"time"
+	shared "tracking-service/internal"
 	model "tracking-service/internal/models"
+	util "tracking-service/internal/utils"
 
 	"gorm.io/gorm"
 )
@@ -12,16 +16,28 @@ type ApplicationRepository interface {
 	GetApplicationByID(ctx context.Context, id string) (*model.Application, error)
 	UpdateApplication(ctx context.Context, application *model.Application) error
 	DeleteApplication(ctx context.Context, application *model.Application) error
-	GetApplications(ctx context.Context) ([]*model.Application, error)
-	GetApplicationByAPIKey(ctx context.Context, apiKey string) (*model.Application, error)
+	// GetApplications 套用 TenantID 篩選，回傳下一頁的 cursor
+	GetApplications(ctx context.Context, filter *ListFilter) ([]*model.Application, string, error)
+	GetApplicationByAPIKeyHash(ctx context.Context, keyHash string) (*model.Application, *model.ApplicationApiKey, error)
 	CreateSession(ctx context.Context, session *model.Session) error
 	GetSessionByApplicationIDAndID(ctx context.Context, applicationID string, id string) (*model.Session, error)
+	GetSessionByApplicationIDAndKey(ctx context.Context, applicationID string, sessionKey string) (*model.Session, error)
 	UpdateSession(ctx context.Context, session *model.Session) error
 	DeleteSession(ctx context.Context, session *model.Session) error
+	// UpdateSessionLastActivityAt 僅更新尚未結束的 session，且不會將時間往前調整
+	UpdateSessionLastActivityAt(ctx context.Context, applicationID string, id string, lastActivityAt time.Time) error
+	// GetIdleSessions 取得閒置超過所屬應用程式逾時的 session，應用程式未設定逾時時使用 defaultTimeout，兩者皆為 0 時不逾時
+	GetIdleSessions(ctx context.Context, defaultTimeout time.Duration, now time.Time, limit int) ([]*model.Session, error)
+	// EndSession 僅結束尚未結束的 session，回傳是否有更新
+	EndSession(ctx context.Context, id string, endedAt time.Time) (bool, error)
 	GetApplicationByTenantIDAndID(ctx context.Context, tenantID string, id string) (*model.Application, error)
 	CreateApplicationAPIKey(ctx context.Context, application *model.ApplicationApiKey) error
 	GetApplicationKeyByID(ctx context.Context, apiKeyID string) (*model.ApplicationApiKey, error)
 	DeleteApplicationAPIKey(ctx context.Context, apiKey *model.ApplicationApiKey) error
+	UpdateApplicationAPIKey(ctx context.Context, apiKey *model.ApplicationApiKey) error
+	RotateApplicationAPIKey(ctx context.Context, oldKey *model.ApplicationApiKey, newKey *model.ApplicationApiKey) error
+	UpdateApplicationAPIKeyLastUsedAt(ctx context.Context, apiKeyID string, lastUsedAt time.Time) error
+	NotifyAPIKeysInvalidated(ctx context.Context, keyHashes []string) error
 }
 
 type applicationRepository struct {
@@ -69,19 +85,27 @@ func (r *applicationRepository) DeleteApplication(ctx context.Context, applicati
 	})
 }
 
-func (r *applicationRepository) GetApplications(ctx context.Context) ([]*model.Application, error) {
+func (r *applicationRepository) GetApplications(ctx context.Context, filter *ListFilter) ([]*model.Application, string, error) {
+	query := applyListFilter(r.db.WithContext(ctx), filter)
+	if filter.TenantID != "" {
+		query = query.Where("tenant_id = ?", filter.TenantID)
+	}
+
 	var applications []*model.Application
-	err := r.db.WithContext(ctx).Find(&applications).Error
-	return applications, err
+	if err := paginate(query, model.Application{}.TableName(), filter).Find(&applications).Error; err != nil {
+		return nil, "", err
+	}
+	applications, nextCursor := nextPage(applications, filter.Limit, func(application *model.Application) string { return application.ID })
+	return applications, nextCursor, nil
 }
 
-func (r *applicationRepository) GetApplicationByAPIKey(ctx context.Context, apiKey string) (*model.Application, error) {
+func (r *applicationRepository) GetApplicationByAPIKeyHash(ctx context.Context, keyHash string) (*model.Application, *model.ApplicationApiKey, error) {
 	var applicationApiKey model.ApplicationApiKey
 	err := r.db.WithContext(ctx).
-		First(&applicationApiKey, "api_key = ?", apiKey).Error
+		First(&applicationApiKey, "key_hash = ?", keyHash).Error
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var application model.Application
@@ -89,10 +113,10 @@ func (r *applicationRepository) GetApplicationByAPIKey(ctx context.Context, apiK
 		First(&application, "id = ?", applicationApiKey.ApplicationID).Error
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &application, nil
+	return &application, &applicationApiKey, nil
 }
 
 func (r *applicationRepository) CreateSession(ctx context.Context, session *model.Session) error {
@@ -110,6 +134,12 @@ func (r *applicationRepository) GetSessionByApplicationIDAndID(ctx context.Conte
 	return &session, err
 }
 
+func (r *applicationRepository) GetSessionByApplicationIDAndKey(ctx context.Context, applicationID string, sessionKey string) (*model.Session, error) {
+	var session model.Session
+	err := r.db.WithContext(ctx).First(&session, "application_id = ? AND session_key = ?", applicationID, sessionKey).Error
+	return &session, err
+}
+
 func (r *applicationRepository) UpdateSession(ctx context.Context, session *model.Session) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Save(session).Error; err != nil {
@@ -128,6 +158,57 @@ func (r *applicationRepository) DeleteSession(ctx context.Context, session *mode
 	})
 }
 
+func (r *applicationRepository) UpdateSessionLastActivityAt(
+	ctx context.Context,
+	applicationID string,
+	id string,
+	lastActivityAt time.Time,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Model(&model.Session{}).
+			Where("application_id = ? AND id = ? AND ended_at IS NULL", applicationID, id).
+			Where("last_activity_at IS NULL OR last_activity_at < ?", lastActivityAt).
+			UpdateColumn("last_activity_at", lastActivityAt).Error
+	})
+}
+
+func (r *applicationRepository) GetIdleSessions(
+	ctx context.Context,
+	defaultTimeout time.Duration,
+	now time.Time,
+	limit int,
+) ([]*model.Session, error) {
+	timeout := "COALESCE(NULLIF(applications.session_timeout_seconds, 0), ?)"
+	defaultSeconds := int64(defaultTimeout.Seconds())
+
+	var sessions []*model.Session
+	err := r.db.WithContext(ctx).
+		Select("sessions.*").
+		Joins("JOIN tracking.applications AS applications ON applications.id = sessions.application_id AND applications.deleted_at IS NULL").
+		Where("sessions.ended_at IS NULL").
+		Where(timeout+" > 0", defaultSeconds).
+		Where("COALESCE(sessions.last_activity_at, sessions.started_at) < ?::timestamptz - make_interval(secs => "+timeout+")", now, defaultSeconds).
+		Order("COALESCE(sessions.last_activity_at, sessions.started_at)").
+		Limit(limit).
+		Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *applicationRepository) EndSession(ctx context.Context, id string, endedAt time.Time) (bool, error) {
+	var updated bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Session{}).
+			Where("id = ? AND ended_at IS NULL", id).
+			Updates(map[string]any{
+				"ended_at":   endedAt,
+				"updated_at": time.Now(),
+			})
+		updated = result.RowsAffected > 0
+		return result.Error
+	})
+	return updated, err
+}
+
 func (r *applicationRepository) GetApplicationByTenantIDAndID(ctx context.Context, tenantID string, id string) (*model.Application, error) {
 	var application model.Application
 	err := r.db.WithContext(ctx).First(&application, "tenant_id = ? AND id = ?", tenantID, id).Error
@@ -157,3 +238,45 @@ func (r *applicationRepository) DeleteApplicationAPIKey(ctx context.Context, api
 		return nil
 	})
 }
+
+func (r *applicationRepository) UpdateApplicationAPIKey(ctx context.Context, apiKey *model.ApplicationApiKey) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(apiKey).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// RotateApplicationAPIKey 於同一交易中縮短舊密鑰的有效期並建立新密鑰
+func (r *applicationRepository) RotateApplicationAPIKey(ctx context.Context, oldKey *model.ApplicationApiKey, newKey *model.ApplicationApiKey) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(oldKey).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(newKey).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// UpdateApplicationAPIKeyLastUsedAt 僅更新 last_used_at，不異動 updated_at
+func (r *applicationRepository) UpdateApplicationAPIKeyLastUsedAt(ctx context.Context, apiKeyID string, lastUsedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.ApplicationApiKey{}).
+		Where("id = ?", apiKeyID).
+		UpdateColumn("last_used_at", lastUsedAt).Error
+}
+
+// NotifyAPIKeysInvalidated 以 pg_notify 通知各節點清除密鑰快取，payload 上限 8000 bytes，因此分批送出
+func (r *applicationRepository) NotifyAPIKeysInvalidated(ctx context.Context, keyHashes []string) error {
+	for _, chunk := range util.ChunkArray(keyHashes, 100) {
+		err := r.db.WithContext(ctx).
+			Exec("SELECT pg_notify(?, ?)", shared.APIKeyInvalidationChannel, strings.Join(chunk, ",")).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// sqlCaptureLogger 記錄 DryRun 產生的 SQL
+type sqlCaptureLogger struct {
+	logger.Interface
+	sql string
+}
+
+func (l *sqlCaptureLogger) LogMode(logger.LogLevel) logger.Interface {
+	return l
+}
+
+func (l *sqlCaptureLogger) Trace(_ context.Context, _ time.Time, fc func() (string, int64), _ error) {
+	l.sql, _ = fc()
+}
+
+func TestApplicationRepositoryGetIdleSessions(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		defaultTimeout time.Duration
+		contains       []string
+	}{
+		{
+			name:           "default timeout in seconds",
+			defaultTimeout: 30 * time.Minute,
+			contains: []string{
+				"sessions.ended_at IS NULL",
+				"COALESCE(NULLIF(applications.session_timeout_seconds, 0), 1800) > 0",
+				"COALESCE(sessions.last_activity_at, sessions.started_at) < '2024-01-01 12:00:00'::timestamptz - make_interval(secs => COALESCE(NULLIF(applications.session_timeout_seconds, 0), 1800))",
+				"ORDER BY COALESCE(sessions.last_activity_at, sessions.started_at) LIMIT 500",
+			},
+		},
+		{
+			// 預設不逾時時僅處理自行設定逾時的應用程式，-1 一律不逾時
+			name:           "default disabled",
+			defaultTimeout: 0,
+			contains: []string{
+				"COALESCE(NULLIF(applications.session_timeout_seconds, 0), 0) > 0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capture := &sqlCaptureLogger{}
+			db := newDryRunDB(t).Session(&gorm.Session{Logger: capture})
+			repo := &applicationRepository{db: db}
+			if _, err := repo.GetIdleSessions(context.Background(), tt.defaultTimeout, now, 500); err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(capture.sql, s) {
+					t.Errorf("query missing %q:\n%s", s, capture.sql)
+				}
+			}
+		})
+	}
+}
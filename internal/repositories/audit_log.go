@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+	model "tracking-service/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogFilter 空字串與 nil 代表不篩選
+type AuditLogFilter struct {
+	TenantID      string
+	ApplicationID string
+	ActorType     string
+	ActorID       string
+	Action        string
+	ResourceType  string
+	ResourceID    string
+	From          *time.Time
+	To            *time.Time
+	// Cursor 為上一頁最後一筆的 ID
+	Cursor string
+	Limit  int
+}
+
+type AuditLogRepository interface {
+	CreateAuditLog(ctx context.Context, auditLog *model.AuditLog) error
+	// GetAuditLogs 依建立時間由新到舊排序，回傳下一頁的 cursor
+	GetAuditLogs(ctx context.Context, filter *AuditLogFilter) ([]*model.AuditLog, string, error)
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{
+		db: db,
+	}
+}
+
+func (r *auditLogRepository) CreateAuditLog(ctx context.Context, auditLog *model.AuditLog) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(auditLog).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *auditLogRepository) GetAuditLogs(ctx context.Context, filter *AuditLogFilter) ([]*model.AuditLog, string, error) {
+	query := r.db.WithContext(ctx)
+	for column, value := range map[string]string{
+		"tenant_id":      filter.TenantID,
+		"application_id": filter.ApplicationID,
+		"actor_type":     filter.ActorType,
+		"actor_id":       filter.ActorID,
+		"action":         filter.Action,
+		"resource_type":  filter.ResourceType,
+		"resource_id":    filter.ResourceID,
+	} {
+		if value != "" {
+			query = query.Where(column+" = ?", value)
+		}
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+
+	page := &ListFilter{
+		Cursor: filter.Cursor,
+		Limit:  filter.Limit,
+		Sort:   ListSortCreatedAtDesc,
+	}
+	var auditLogs []*model.AuditLog
+	if err := paginate(query, model.AuditLog{}.TableName(), page).Find(&auditLogs).Error; err != nil {
+		return nil, "", err
+	}
+
+	auditLogs, nextCursor := nextPage(auditLogs, filter.Limit, func(auditLog *model.AuditLog) string { return auditLog.ID })
+	return auditLogs, nextCursor, nil
+}
@@ -11,16 +11,17 @@ type EventRepository interface {
 	CreateEvent(ctx context.Context, event *model.Event) error
 	GetEventByID(ctx context.Context, id string) (*model.Event, error)
 	UpdateEvent(ctx context.Context, event *model.Event) error
-	GetEvents(ctx context.Context) ([]*model.Event, error)
+	// GetEvents 套用 TenantID、ApplicationID、PlatformID 與 IsActive 篩選，回傳下一頁的 cursor
+	GetEvents(ctx context.Context, filter *ListFilter) ([]*model.Event, string, error)
 	DeleteEvent(ctx context.Context, event *model.Event) error
 	CreateEventField(ctx context.Context, eventField *model.EventField) error
 	GetEventFieldByEventIDAndID(ctx context.Context, eventID string, fieldID string) (*model.EventField, error)
 	UpdateEventField(ctx context.Context, eventField *model.EventField) error
 	DeleteEventField(ctx context.Context, eventField *model.EventField) error
-	GetEventFields(ctx context.Context, eventID string) ([]*model.EventField, error)
-	GetEventsByApplicationID(ctx context.Context, applicationID string) ([]*model.Event, error)
+	GetEventFields(ctx context.Context, eventID string, filter *ListFilter) ([]*model.EventField, string, error)
+	// GetEventsByApplicationID 同 GetEvents 並一併載入欄位
+	GetEventsByApplicationID(ctx context.Context, applicationID string, filter *ListFilter) ([]*model.Event, string, error)
 	GetEventByApplicationIDAndID(ctx context.Context, applicationID string, id string) (*model.Event, error)
-	CreateEventLog(ctx context.Context, eventLog *model.EventLog) error
 }
 
 type eventRepository struct {
@@ -68,10 +69,32 @@ func (r *eventRepository) DeleteEvent(ctx context.Context, event *model.Event) e
 	})
 }
 
-func (r *eventRepository) GetEvents(ctx context.Context) ([]*model.Event, error) {
+func (r *eventRepository) GetEvents(ctx context.Context, filter *ListFilter) ([]*model.Event, string, error) {
+	return r.getEvents(r.db.WithContext(ctx), filter)
+}
+
+func (r *eventRepository) getEvents(query *gorm.DB, filter *ListFilter) ([]*model.Event, string, error) {
+	query = applyListFilter(query, filter)
+	if filter.TenantID != "" {
+		query = query.Where("application_id IN (?)",
+			r.db.Model(&model.Application{}).Select("id").Where("tenant_id = ?", filter.TenantID))
+	}
+	if filter.ApplicationID != "" {
+		query = query.Where("application_id = ?", filter.ApplicationID)
+	}
+	if filter.PlatformID != 0 {
+		query = query.Where("platform_id = ?", filter.PlatformID)
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+
 	var events []*model.Event
-	err := r.db.WithContext(ctx).Find(&events).Error
-	return events, err
+	if err := paginate(query, model.Event{}.TableName(), filter).Find(&events).Error; err != nil {
+		return nil, "", err
+	}
+	events, nextCursor := nextPage(events, filter.Limit, func(event *model.Event) string { return event.ID })
+	return events, nextCursor, nil
 }
 
 func (r *eventRepository) CreateEventField(ctx context.Context, eventField *model.EventField) error {
@@ -101,10 +124,15 @@ func (r *eventRepository) DeleteEventField(ctx context.Context, eventField *mode
 	})
 }
 
-func (r *eventRepository) GetEventFields(ctx context.Context, eventID string) ([]*model.EventField, error) {
+func (r *eventRepository) GetEventFields(ctx context.Context, eventID string, filter *ListFilter) ([]*model.EventField, string, error) {
+	query := applyListFilter(r.db.WithContext(ctx), filter).Where("event_id = ?", eventID)
+
 	var eventFields []*model.EventField
-	err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Find(&eventFields).Error
-	return eventFields, err
+	if err := paginate(query, model.EventField{}.TableName(), filter).Find(&eventFields).Error; err != nil {
+		return nil, "", err
+	}
+	eventFields, nextCursor := nextPage(eventFields, filter.Limit, func(eventField *model.EventField) string { return eventField.ID })
+	return eventFields, nextCursor, nil
 }
 
 func (r *eventRepository) GetEventByApplicationID(ctx context.Context, id string) (*model.Event, error) {
@@ -113,12 +141,11 @@ func (r *eventRepository) GetEventByApplicationID(ctx context.Context, id string
 	return &event, err
 }
 
-func (r *eventRepository) GetEventsByApplicationID(ctx context.Context, applicationID string) ([]*model.Event, error) {
-	var events []*model.Event
-	err := r.db.WithContext(ctx).
+func (r *eventRepository) GetEventsByApplicationID(ctx context.Context, applicationID string, filter *ListFilter) ([]*model.Event, string, error) {
+	query := r.db.WithContext(ctx).
 		Preload("Fields").
-		Where("application_id = ?", applicationID).Find(&events).Error
-	return events, err
+		Where("application_id = ?", applicationID)
+	return r.getEvents(query, filter)
 }
 
 func (r *eventRepository) GetEventByApplicationIDAndID(ctx context.Context, applicationID string, id string) (*model.Event, error) {
@@ -129,15 +156,6 @@ func (r *eventRepository) GetEventByApplicationIDAndID(ctx context.Context, appl
 	return &event, err
 }
 
-func (r *eventRepository) CreateEventLog(ctx context.Context, eventLog *model.EventLog) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Create(eventLog).Error; err != nil {
-			return err
-		}
-		return nil
-	})
-}
-
 func (r *eventRepository) GetEventFieldByEventIDAndID(ctx context.Context, eventID string, fieldID string) (*model.EventField, error) {
 	var eventField model.EventField
 	err := r.db.WithContext(ctx).First(&eventField, "event_id = ? AND id = ?", eventID, fieldID).Error
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	component "tracking-service/internal/components"
+	model "tracking-service/internal/models"
+)
+
+const clickhouseDateTimeLayout = "2006-01-02 15:04:05.000"
+
+type EventLogRepository interface {
+	InsertEventLogs(ctx context.Context, eventLogs []*model.EventLog) error
+}
+
+type eventLogRepository struct {
+	clickhouse *component.Clickhouse
+}
+
+func NewEventLogRepository(clickhouse *component.Clickhouse) EventLogRepository {
+	return &eventLogRepository{
+		clickhouse: clickhouse,
+	}
+}
+
+// clickhouseEventLog 對應 ClickHouse event_logs 資料表欄位
+type clickhouseEventLog struct {
+	ID              string   `json:"id"`
+	MessageID       string   `json:"message_id"`
+	ApplicationID   string   `json:"application_id"`
+	SessionID       string   `json:"session_id"`
+	UserID          string   `json:"user_id"`
+	EventID         string   `json:"event_id"`
+	PlatformID      int      `json:"platform_id"`
+	Properties      string   `json:"properties"`
+	ClientTimestamp *string  `json:"client_timestamp"`
+	Violations      []string `json:"violations"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+func (r *eventLogRepository) InsertEventLogs(ctx context.Context, eventLogs []*model.EventLog) error {
+	rows := make([]any, 0, len(eventLogs))
+	for _, eventLog := range eventLogs {
+		properties, err := json.Marshal(eventLog.Properties)
+		if err != nil {
+			return err
+		}
+		var clientTimestamp *string
+		if eventLog.ClientTimestamp != nil {
+			t := eventLog.ClientTimestamp.UTC().Format(clickhouseDateTimeLayout)
+			clientTimestamp = &t
+		}
+		var userID string
+		if eventLog.UserID != nil {
+			userID = *eventLog.UserID
+		}
+		// Array 欄位不接受 null
+		violations := []string(eventLog.Violations)
+		if violations == nil {
+			violations = []string{}
+		}
+		rows = append(rows, clickhouseEventLog{
+			ID:              eventLog.ID,
+			MessageID:       eventLog.MessageID,
+			ApplicationID:   eventLog.ApplicationID,
+			SessionID:       eventLog.SessionID,
+			UserID:          userID,
+			EventID:         eventLog.EventID,
+			PlatformID:      eventLog.PlatformID,
+			Properties:      string(properties),
+			ClientTimestamp: clientTimestamp,
+			Violations:      violations,
+			CreatedAt:       eventLog.CreatedAt.UTC().Format(clickhouseDateTimeLayout),
+		})
+	}
+
+	return r.clickhouse.Insert(ctx, "event_logs", rows)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	util "tracking-service/internal/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyRepository interface {
+	// ReserveIdempotencyKey 於有效期間內首次使用時寫入並回傳 true，重複時回傳先前記錄的 key 與 false
+	ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) (*model.IdempotencyKey, bool, error)
+	DeleteIdempotencyKey(ctx context.Context, applicationID string, messageID string) error
+	// DeleteExpiredIdempotencyKeys 刪除最多 limit 筆已過期的 key，回傳刪除筆數
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time, limit int) (int64, error)
+}
+
+// NewIdempotencyRepository 依設定選擇單節點使用的記憶體 LRU 或多節點共用的 Postgres
+func NewIdempotencyRepository(config *shared.Config, db *gorm.DB) IdempotencyRepository {
+	if config.IdempotencyStore == shared.IdempotencyStorePostgres {
+		return &idempotencyRepository{
+			db: db,
+		}
+	}
+	return &memoryIdempotencyRepository{
+		cache: util.NewLRU[string, *model.IdempotencyKey](config.IdempotencyCacheSize, config.IdempotencyWindow),
+	}
+}
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+func (r *idempotencyRepository) ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) (*model.IdempotencyKey, bool, error) {
+	var existing model.IdempotencyKey
+	reserved := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 已過期的 key 直接覆寫，視為新的請求
+		result := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "application_id"}, {Name: "message_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"event_log_id", "event_log", "expires_at", "created_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "idempotency_keys.expires_at <= ?", Vars: []interface{}{key.CreatedAt}},
+			}},
+		}).Create(key)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			reserved = true
+			return nil
+		}
+
+		return tx.Where("application_id = ? AND message_id = ?", key.ApplicationID, key.MessageID).
+			First(&existing).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if reserved {
+		return key, true, nil
+	}
+	return &existing, false, nil
+}
+
+func (r *idempotencyRepository) DeleteIdempotencyKey(ctx context.Context, applicationID string, messageID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Where("application_id = ? AND message_id = ?", applicationID, messageID).
+			Delete(&model.IdempotencyKey{}).Error
+	})
+}
+
+func (r *idempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time, limit int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		"DELETE FROM tracking.idempotency_keys WHERE ctid IN (SELECT ctid FROM tracking.idempotency_keys WHERE expires_at <= ? LIMIT ?)",
+		now, limit,
+	)
+	return result.RowsAffected, result.Error
+}
+
+type memoryIdempotencyRepository struct {
+	cache *util.LRU[string, *model.IdempotencyKey]
+}
+
+func (r *memoryIdempotencyRepository) ReserveIdempotencyKey(_ context.Context, key *model.IdempotencyKey) (*model.IdempotencyKey, bool, error) {
+	existing, reserved := r.cache.SetIfAbsent(memoryIdempotencyKey(key.ApplicationID, key.MessageID), key)
+	return existing, reserved, nil
+}
+
+func (r *memoryIdempotencyRepository) DeleteIdempotencyKey(_ context.Context, applicationID string, messageID string) error {
+	r.cache.Delete(memoryIdempotencyKey(applicationID, messageID))
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys LRU 於讀取時淘汰過期項目，不需另外清除
+func (r *memoryIdempotencyRepository) DeleteExpiredIdempotencyKeys(context.Context, time.Time, int) (int64, error) {
+	return 0, nil
+}
+
+func memoryIdempotencyKey(applicationID string, messageID string) string {
+	return applicationID + ":" + messageID
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+)
+
+func TestMemoryIdempotencyRepositoryReserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		reserved     [][2]string
+		wait         time.Duration
+		key          [2]string
+		wantReserved bool
+	}{
+		{name: "first use", key: [2]string{"app-1", "msg-1"}, wantReserved: true},
+		{name: "repeated within window", reserved: [][2]string{{"app-1", "msg-1"}}, key: [2]string{"app-1", "msg-1"}, wantReserved: false},
+		{name: "same message id of another application", reserved: [][2]string{{"app-2", "msg-1"}}, key: [2]string{"app-1", "msg-1"}, wantReserved: true},
+		{name: "repeated after window", reserved: [][2]string{{"app-1", "msg-1"}}, wait: 20 * time.Millisecond, key: [2]string{"app-1", "msg-1"}, wantReserved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := NewIdempotencyRepository(&shared.Config{
+				IdempotencyStore:     shared.IdempotencyStoreMemory,
+				IdempotencyCacheSize: 10,
+				IdempotencyWindow:    10 * time.Millisecond,
+			}, nil)
+			for _, k := range tt.reserved {
+				if _, _, err := repo.ReserveIdempotencyKey(ctx, &model.IdempotencyKey{ApplicationID: k[0], MessageID: k[1], EventLogID: "previous"}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			time.Sleep(tt.wait)
+
+			key := &model.IdempotencyKey{ApplicationID: tt.key[0], MessageID: tt.key[1], EventLogID: "current"}
+			existing, reserved, err := repo.ReserveIdempotencyKey(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reserved != tt.wantReserved {
+				t.Fatalf("reserved = %v, want %v", reserved, tt.wantReserved)
+			}
+			if !reserved && existing.EventLogID != "previous" {
+				t.Errorf("existing event log id = %q, want previous", existing.EventLogID)
+			}
+		})
+	}
+}
+
+func TestMemoryIdempotencyRepositoryDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := NewIdempotencyRepository(&shared.Config{
+		IdempotencyStore:     shared.IdempotencyStoreMemory,
+		IdempotencyCacheSize: 10,
+		IdempotencyWindow:    time.Minute,
+	}, nil)
+	key := &model.IdempotencyKey{ApplicationID: "app-1", MessageID: "msg-1"}
+	if _, _, err := repo.ReserveIdempotencyKey(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	// 寫入失敗後刪除，重送時可再次保留
+	if err := repo.DeleteIdempotencyKey(ctx, "app-1", "msg-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, reserved, _ := repo.ReserveIdempotencyKey(ctx, key); !reserved {
+		t.Error("reserved = false after delete, want true")
+	}
+}
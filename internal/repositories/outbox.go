@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"time"
+	model "tracking-service/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxLease 為領取訊息後延後下次嘗試的時間，期間內其他節點不會重複傳送
+const OutboxLease = 30 * time.Second
+
+type OutboxStatusCount struct {
+	Status string
+	Count  int64
+}
+
+type OutboxRepository interface {
+	CreateOutboxMessages(ctx context.Context, messages []*model.OutboxMessage) error
+	ClaimDueOutboxMessages(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxMessage, error)
+	MarkOutboxMessagesDelivered(ctx context.Context, ids []string, deliveredAt time.Time) error
+	UpdateOutboxMessage(ctx context.Context, message *model.OutboxMessage) error
+	CountOutboxMessagesByStatus(ctx context.Context) ([]*OutboxStatusCount, error)
+	GetOldestPendingOutboxMessage(ctx context.Context) (*model.OutboxMessage, error)
+	RedriveOutboxMessages(ctx context.Context) (int64, error)
+	// DeleteDeliveredOutboxMessages 刪除最多 limit 筆於 before 之前送達的訊息，回傳刪除筆數
+	DeleteDeliveredOutboxMessages(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
+
+func (r *outboxRepository) CreateOutboxMessages(ctx context.Context, messages []*model.OutboxMessage) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(messages).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// ClaimDueOutboxMessages 鎖定到期的待送訊息並延後下次嘗試時間，避免多個節點重複傳送
+func (r *outboxRepository) ClaimDueOutboxMessages(ctx context.Context, limit int, lease time.Duration) ([]*model.OutboxMessage, error) {
+	var messages []*model.OutboxMessage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", model.OutboxStatusPending, now).
+			Order("id").
+			Limit(limit).
+			Find(&messages).Error
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+		return tx.Model(&model.OutboxMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"next_attempt_at": now.Add(lease),
+				"updated_at":      now,
+			}).Error
+	})
+	return messages, err
+}
+
+func (r *outboxRepository) MarkOutboxMessagesDelivered(ctx context.Context, ids []string, deliveredAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Model(&model.OutboxMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":       model.OutboxStatusDelivered,
+				"delivered_at": deliveredAt,
+				"updated_at":   deliveredAt,
+			}).Error
+	})
+}
+
+func (r *outboxRepository) UpdateOutboxMessage(ctx context.Context, message *model.OutboxMessage) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(message).Error; err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *outboxRepository) CountOutboxMessagesByStatus(ctx context.Context) ([]*OutboxStatusCount, error) {
+	var counts []*OutboxStatusCount
+	err := r.db.WithContext(ctx).
+		Model(&model.OutboxMessage{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&counts).Error
+	return counts, err
+}
+
+func (r *outboxRepository) GetOldestPendingOutboxMessage(ctx context.Context) (*model.OutboxMessage, error) {
+	var message model.OutboxMessage
+	err := r.db.WithContext(ctx).
+		Where("status = ?", model.OutboxStatusPending).
+		Order("id").
+		First(&message).Error
+	return &message, err
+}
+
+// RedriveOutboxMessages 將失敗與等待中的訊息重設為立即重送
+// 最近 OutboxLease 內曾領取或更新的訊息可能正在傳送，不重設以免重複傳送
+func (r *outboxRepository) RedriveOutboxMessages(ctx context.Context) (int64, error) {
+	var affected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&model.OutboxMessage{}).
+			Where("status = ? OR (status = ? AND (next_attempt_at <= ? OR updated_at <= ?))",
+				model.OutboxStatusFailed, model.OutboxStatusPending, now, now.Add(-OutboxLease)).
+			Updates(map[string]interface{}{
+				"status":          model.OutboxStatusPending,
+				"attempts":        0,
+				"next_attempt_at": now,
+				"updated_at":      now,
+			})
+		affected = result.RowsAffected
+		return result.Error
+	})
+	return affected, err
+}
+
+func (r *outboxRepository) DeleteDeliveredOutboxMessages(ctx context.Context, before time.Time, limit int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		"DELETE FROM tracking.outbox_messages WHERE ctid IN (SELECT ctid FROM tracking.outbox_messages WHERE status = ? AND delivered_at < ? LIMIT ?)",
+		model.OutboxStatusDelivered, before, limit,
+	)
+	return result.RowsAffected, result.Error
+}
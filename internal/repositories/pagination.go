@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ListSortCreatedAt     = "created_at"
+	ListSortCreatedAtDesc = "-created_at"
+	ListSortName          = "name"
+	ListSortNameDesc      = "-name"
+)
+
+// ListFilter 列表的篩選、排序與分頁條件，空字串、0 與 nil 代表不篩選，各列表僅套用適用的欄位
+type ListFilter struct {
+	// Cursor 為上一頁最後一筆的 ID
+	Cursor        string
+	Limit         int
+	Sort          string
+	NameContains  string
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	TenantID      string
+	ApplicationID string
+	PlatformID    int
+	IsActive      *bool
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// applyListFilter 套用名稱與建立時間的篩選
+func applyListFilter(query *gorm.DB, filter *ListFilter) *gorm.DB {
+	if filter.NameContains != "" {
+		query = query.Where("name ILIKE ?", "%"+likeEscaper.Replace(filter.NameContains)+"%")
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *filter.CreatedTo)
+	}
+	return query
+}
+
+// paginate 以 (排序欄位, id) 作為 keyset，由 cursor 對應的資料列取得起點，該列已刪除時仍可繼續翻頁
+// 多取一筆供 nextPage 判斷是否還有下一頁
+func paginate(query *gorm.DB, table string, filter *ListFilter) *gorm.DB {
+	column, direction, operator := "created_at", "ASC", ">"
+	switch filter.Sort {
+	case ListSortCreatedAtDesc:
+		direction, operator = "DESC", "<"
+	case ListSortName:
+		column = "name"
+	case ListSortNameDesc:
+		column, direction, operator = "name", "DESC", "<"
+	}
+
+	if filter.Cursor != "" {
+		query = query.Where(
+			fmt.Sprintf("(%s, id) %s (SELECT %s, id FROM %s WHERE id = ?)", column, operator, column, table),
+			filter.Cursor,
+		)
+	}
+	return query.
+		Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).
+		Limit(filter.Limit + 1)
+}
+
+// nextPage 截去多取的一筆，回傳本頁資料與下一頁的 cursor，沒有下一頁時 cursor 為空字串
+func nextPage[T any](items []T, limit int, cursorOf func(T) string) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+	items = items[:limit]
+	return items, cursorOf(items[limit-1])
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *ListFilter
+		want   string
+	}{
+		{
+			name:   "default sort",
+			filter: &ListFilter{Limit: 20},
+			want:   `SELECT * FROM "tracking"."applications" ORDER BY created_at ASC, id ASC LIMIT 21`,
+		},
+		{
+			name:   "created_at descending with cursor",
+			filter: &ListFilter{Limit: 20, Sort: ListSortCreatedAtDesc, Cursor: "app-1"},
+			want:   `SELECT * FROM "tracking"."applications" WHERE (created_at, id) < (SELECT created_at, id FROM tracking.applications WHERE id = 'app-1') ORDER BY created_at DESC, id DESC LIMIT 21`,
+		},
+		{
+			name:   "name ascending with cursor",
+			filter: &ListFilter{Limit: 10, Sort: ListSortName, Cursor: "app-1"},
+			want:   `SELECT * FROM "tracking"."applications" WHERE (name, id) > (SELECT name, id FROM tracking.applications WHERE id = 'app-1') ORDER BY name ASC, id ASC LIMIT 11`,
+		},
+		{
+			name:   "name descending",
+			filter: &ListFilter{Limit: 10, Sort: ListSortNameDesc},
+			want:   `SELECT * FROM "tracking"."applications" ORDER BY name DESC, id DESC LIMIT 11`,
+		},
+	}
+
+	db := newDryRunDB(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				var rows []map[string]interface{}
+				return paginate(tx.Table("tracking.applications"), "tracking.applications", tt.filter).Find(&rows)
+			})
+			if got != tt.want {
+				t.Errorf("paginate() SQL =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyListFilterEscapesLike(t *testing.T) {
+	db := newDryRunDB(t)
+	got := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var rows []map[string]interface{}
+		return applyListFilter(tx.Table("tracking.applications"), &ListFilter{NameContains: `50%_off\`}).Find(&rows)
+	})
+	if want := `name ILIKE '%50\%\_off\\%'`; !strings.Contains(got, want) {
+		t.Errorf("applyListFilter() SQL = %s, want containing %s", got, want)
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	tests := []struct {
+		name       string
+		items      []string
+		limit      int
+		wantItems  int
+		wantCursor string
+	}{
+		{name: "empty", items: nil, limit: 2, wantItems: 0, wantCursor: ""},
+		{name: "fewer than limit", items: []string{"a"}, limit: 2, wantItems: 1, wantCursor: ""},
+		{name: "exactly limit", items: []string{"a", "b"}, limit: 2, wantItems: 2, wantCursor: ""},
+		{name: "one extra row", items: []string{"a", "b", "c"}, limit: 2, wantItems: 2, wantCursor: "b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, cursor := nextPage(tt.items, tt.limit, func(s string) string { return s })
+			if len(items) != tt.wantItems || cursor != tt.wantCursor {
+				t.Errorf("nextPage() = %d items, cursor %q, want %d items, cursor %q", len(items), cursor, tt.wantItems, tt.wantCursor)
+			}
+		})
+	}
+}
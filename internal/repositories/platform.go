@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strconv"
 	model "tracking-service/internal/models"
 
 	"gorm.io/gorm"
@@ -10,7 +11,8 @@ import (
 type PlatformRepository interface {
 	CreatePlatform(ctx context.Context, platform *model.Platform) error
 	GetPlatformByID(ctx context.Context, id int) (*model.Platform, error)
-	GetPlatforms(ctx context.Context) ([]*model.Platform, error)
+	// GetPlatforms 回傳下一頁的 cursor
+	GetPlatforms(ctx context.Context, filter *ListFilter) ([]*model.Platform, string, error)
 }
 
 type platformRepository struct {
@@ -38,8 +40,13 @@ func (r *platformRepository) GetPlatformByID(ctx context.Context, id int) (*mode
 	return &platform, err
 }
 
-func (r *platformRepository) GetPlatforms(ctx context.Context) ([]*model.Platform, error) {
+func (r *platformRepository) GetPlatforms(ctx context.Context, filter *ListFilter) ([]*model.Platform, string, error) {
+	query := applyListFilter(r.db.WithContext(ctx), filter)
+
 	var platforms []*model.Platform
-	err := r.db.WithContext(ctx).Find(&platforms).Error
-	return platforms, err
+	if err := paginate(query, model.Platform{}.TableName(), filter).Find(&platforms).Error; err != nil {
+		return nil, "", err
+	}
+	platforms, nextCursor := nextPage(platforms, filter.Limit, func(platform *model.Platform) string { return strconv.Itoa(platform.ID) })
+	return platforms, nextCursor, nil
 }
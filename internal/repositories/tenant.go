@@ -11,7 +11,8 @@ type TenantRepository interface {
 	CreateTenant(ctx context.Context, tenant *model.Tenant) error
 	GetTenantByID(ctx context.Context, id string) (*model.Tenant, error)
 	UpdateTenant(ctx context.Context, tenant *model.Tenant) error
-	GetTenants(ctx context.Context) ([]*model.Tenant, error)
+	// GetTenants 套用 TenantID 篩選，回傳下一頁的 cursor
+	GetTenants(ctx context.Context, filter *ListFilter) ([]*model.Tenant, string, error)
 }
 
 type tenantRepository struct {
@@ -48,8 +49,16 @@ func (r *tenantRepository) UpdateTenant(ctx context.Context, tenant *model.Tenan
 	})
 }
 
-func (r *tenantRepository) GetTenants(ctx context.Context) ([]*model.Tenant, error) {
+func (r *tenantRepository) GetTenants(ctx context.Context, filter *ListFilter) ([]*model.Tenant, string, error) {
+	query := applyListFilter(r.db.WithContext(ctx), filter)
+	if filter.TenantID != "" {
+		query = query.Where("id = ?", filter.TenantID)
+	}
+
 	var tenants []*model.Tenant
-	err := r.db.WithContext(ctx).Find(&tenants).Error
-	return tenants, err
+	if err := paginate(query, model.Tenant{}.TableName(), filter).Find(&tenants).Error; err != nil {
+		return nil, "", err
+	}
+	tenants, nextCursor := nextPage(tenants, filter.Limit, func(tenant *model.Tenant) string { return tenant.ID })
+	return tenants, nextCursor, nil
 }
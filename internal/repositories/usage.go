@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+	model "tracking-service/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UsageTotal struct {
+	Metric   string
+	Accepted int64
+	Rejected int64
+}
+
+type UsageRepository interface {
+	// IncrementUsageDaily 將數量累加至既有的每日用量，不存在時新增
+	IncrementUsageDaily(ctx context.Context, usages []*model.UsageDaily) error
+	// GetUsageDaily applicationID 為空字串時取得租戶下所有應用程式
+	GetUsageDaily(ctx context.Context, tenantID string, applicationID string, from time.Time, to time.Time) ([]*model.UsageDaily, error)
+	SumUsageByTenant(ctx context.Context, tenantID string, from time.Time, to time.Time) ([]*UsageTotal, error)
+}
+
+type usageRepository struct {
+	db *gorm.DB
+}
+
+func NewUsageRepository(db *gorm.DB) UsageRepository {
+	return &usageRepository{
+		db: db,
+	}
+}
+
+func (r *usageRepository) IncrementUsageDaily(ctx context.Context, usages []*model.UsageDaily) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "application_id"}, {Name: "usage_date"}, {Name: "metric"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"accepted":   gorm.Expr("usage_daily.accepted + excluded.accepted"),
+				"rejected":   gorm.Expr("usage_daily.rejected + excluded.rejected"),
+				"updated_at": gorm.Expr("excluded.updated_at"),
+			}),
+		}).Create(&usages).Error
+	})
+}
+
+func (r *usageRepository) GetUsageDaily(
+	ctx context.Context,
+	tenantID string,
+	applicationID string,
+	from time.Time,
+	to time.Time,
+) ([]*model.UsageDaily, error) {
+	query := r.db.WithContext(ctx).
+		Where("tenant_id = ? AND usage_date BETWEEN ? AND ?", tenantID, from, to)
+	if applicationID != "" {
+		query = query.Where("application_id = ?", applicationID)
+	}
+
+	var usages []*model.UsageDaily
+	err := query.Order("usage_date, application_id, metric").Find(&usages).Error
+	return usages, err
+}
+
+func (r *usageRepository) SumUsageByTenant(ctx context.Context, tenantID string, from time.Time, to time.Time) ([]*UsageTotal, error) {
+	var totals []*UsageTotal
+	err := r.db.WithContext(ctx).
+		Model(&model.UsageDaily{}).
+		Select("metric, SUM(accepted) AS accepted, SUM(rejected) AS rejected").
+		Where("tenant_id = ? AND usage_date BETWEEN ? AND ?", tenantID, from, to).
+		Group("metric").
+		Scan(&totals).Error
+	return totals, err
+}
@@ -4,58 +4,107 @@ import (
 	shared "tracking-service/internal"
 	handler "tracking-service/internal/handlers"
 	middleware "tracking-service/internal/middlewares"
+	service "tracking-service/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
 type AdminRoutes struct {
-	config  *shared.Config
-	handler *handler.AdminHandler
+	config             *shared.Config
+	handler            *handler.AdminHandler
+	admin_user_handler *handler.AdminUserHandler
+	admin_user_service *service.AdminUserService
+	rate_limit_service *service.RateLimitService
 }
 
 func NewAdminRoutes(
 	config *shared.Config,
 	handler *handler.AdminHandler,
+	admin_user_handler *handler.AdminUserHandler,
+	admin_user_service *service.AdminUserService,
+	rate_limit_service *service.RateLimitService,
 ) *AdminRoutes {
 	return &AdminRoutes{
-		config:  config,
-		handler: handler,
+		config:             config,
+		handler:            handler,
+		admin_user_handler: admin_user_handler,
+		admin_user_service: admin_user_service,
+		rate_limit_service: rate_limit_service,
 	}
 }
 
 func (ar *AdminRoutes) RegisterRoutes(r *gin.Engine) {
+	r.POST("/admin/login", middleware.AdminLoginRateLimitMiddleware(ar.config, ar.rate_limit_service), ar.admin_user_handler.Login)
+
 	group := r.Group(
 		"/admin",
-		middleware.AdminAuthMiddleware(ar.config),
+		middleware.AdminAuthMiddleware(ar.admin_user_service),
+	)
+
+	group.GET("/me", ar.admin_user_handler.GetCurrentAdminUser)
+
+	// ADMIN_API_KEY 僅能管理後台使用者
+	users := group.Group("/users", middleware.RequireAdminRole(shared.AdminRoleSuperAdmin, shared.AdminRoleBootstrap))
+	users.POST("", ar.admin_user_handler.CreateAdminUser)
+	users.GET("/:user_id", ar.admin_user_handler.GetAdminUser)
+	users.PUT("/:user_id", ar.admin_user_handler.UpdateAdminUser)
+	users.DELETE("/:user_id", ar.admin_user_handler.DeleteAdminUser)
+	users.GET("", ar.admin_user_handler.GetAdminUsers)
+
+	super := group.Group("", middleware.RequireAdminRole(shared.AdminRoleSuperAdmin))
+	// 限定租戶的使用者僅能存取路徑中所屬租戶的資源，列表於 handler 中過濾
+	read := group.Group(
+		"",
+		middleware.RequireAdminRole(shared.AdminRoleSuperAdmin, shared.AdminRoleTenantAdmin, shared.AdminRoleReadOnly),
+		middleware.RequireAdminTenantAccess(ar.admin_user_service),
+	)
+	write := group.Group(
+		"",
+		middleware.RequireAdminRole(shared.AdminRoleSuperAdmin, shared.AdminRoleTenantAdmin),
+		middleware.RequireAdminTenantAccess(ar.admin_user_service),
 	)
 
-	group.POST("/tenants", ar.handler.CreateTenant)
-	group.GET("/tenants/:tenant_id", ar.handler.GetTenant)
-	group.PUT("/tenants/:tenant_id", ar.handler.UpdateTenant)
-	group.GET("/tenants", ar.handler.GetTenants)
+	super.POST("/tenants", ar.handler.CreateTenant)
+	read.GET("/tenants/:tenant_id", ar.handler.GetTenant)
+	super.PUT("/tenants/:tenant_id", ar.handler.UpdateTenant)
+	read.GET("/tenants", ar.handler.GetTenants)
+	read.GET("/tenants/:tenant_id/usage", ar.handler.GetTenantUsage)
 
 	// 多租戶共用不提供修改和刪除，特殊需求請新增(或依需求擴充功能)
-	group.POST("/platforms", ar.handler.CreatePlatform)
-	group.GET("/platforms/:platform_id", ar.handler.GetPlatform)
-	group.GET("/platforms", ar.handler.GetPlatforms)
-
-	group.POST("/apps", ar.handler.CreateApp)
-	group.GET("/apps/:app_id", ar.handler.GetApp)
-	group.PUT("/apps/:app_id", ar.handler.UpdateApp)
-	group.DELETE("/apps/:app_id", ar.handler.DeleteApp)
-	group.GET("/apps", ar.handler.GetApps)
-	group.POST("/apps/:app_id/api-keys", ar.handler.CreateAppAPIKey)
-	group.DELETE("/apps/:app_id/api-keys/:api_key_id", ar.handler.DeleteAppAPIKey)
-
-	group.POST("/apps/:app_id/events", ar.handler.CreateEvent)
-	group.GET("/apps/:app_id/events/:event_id", ar.handler.GetEvent)
-	group.PUT("/apps/:app_id/events/:event_id", ar.handler.UpdateEvent)
-	group.DELETE("/apps/:app_id/events/:event_id", ar.handler.DeleteEvent)
-	group.GET("/apps/:app_id/events", ar.handler.GetEvents)
-
-	group.POST("/apps/:app_id/events/:event_id/fields", ar.handler.CreateEventFields)
-	group.GET("/apps/:app_id/events/:event_id/fields/:field_id", ar.handler.GetEventField)
-	group.PUT("/apps/:app_id/events/:event_id/fields/:field_id", ar.handler.UpdateEventField)
-	group.DELETE("/apps/:app_id/events/:event_id/fields/:field_id", ar.handler.DeleteEventField)
-	group.GET("/apps/:app_id/events/:event_id/fields", ar.handler.GetEventFields)
+	super.POST("/platforms", ar.handler.CreatePlatform)
+	read.GET("/platforms/:platform_id", ar.handler.GetPlatform)
+	read.GET("/platforms", ar.handler.GetPlatforms)
+
+	write.POST("/apps", ar.handler.CreateApp)
+	read.GET("/apps/:app_id", ar.handler.GetApp)
+	write.PUT("/apps/:app_id", ar.handler.UpdateApp)
+	write.DELETE("/apps/:app_id", ar.handler.DeleteApp)
+	read.GET("/apps", ar.handler.GetApps)
+	write.POST("/apps/:app_id/api-keys", ar.handler.CreateAppAPIKey)
+	write.DELETE("/apps/:app_id/api-keys/:api_key_id", ar.handler.DeleteAppAPIKey)
+	write.POST("/apps/:app_id/api-keys/:api_key_id/rotate", ar.handler.RotateAppAPIKey)
+	write.POST("/apps/:app_id/api-keys/:api_key_id/revoke", ar.handler.RevokeAppAPIKey)
+
+	write.POST("/apps/:app_id/events", ar.handler.CreateEvent)
+	read.GET("/apps/:app_id/events/:event_id", ar.handler.GetEvent)
+	write.PUT("/apps/:app_id/events/:event_id", ar.handler.UpdateEvent)
+	write.DELETE("/apps/:app_id/events/:event_id", ar.handler.DeleteEvent)
+	read.GET("/apps/:app_id/events", ar.handler.GetEvents)
+
+	write.POST("/apps/:app_id/events/:event_id/fields", ar.handler.CreateEventFields)
+	read.GET("/apps/:app_id/events/:event_id/fields/:field_id", ar.handler.GetEventField)
+	write.PUT("/apps/:app_id/events/:event_id/fields/:field_id", ar.handler.UpdateEventField)
+	write.DELETE("/apps/:app_id/events/:event_id/fields/:field_id", ar.handler.DeleteEventField)
+	read.GET("/apps/:app_id/events/:event_id/fields", ar.handler.GetEventFields)
+
+	read.GET("/apps/:app_id/analytics/events", ar.handler.GetEventAnalytics)
+	read.POST("/apps/:app_id/analytics/funnels", ar.handler.CreateFunnel)
+	read.POST("/apps/:app_id/analytics/retention", ar.handler.CreateRetention)
+	read.POST("/apps/:app_id/analytics/paths", ar.handler.CreatePath)
+	read.GET("/apps/:app_id/analytics/sessions", ar.handler.GetSessionAnalytics)
+
+	read.GET("/audit-logs", ar.handler.GetAuditLogs)
+
+	super.GET("/outbox", ar.handler.GetOutboxStats)
+	super.POST("/outbox/redrive", ar.handler.RedriveOutbox)
 }
@@ -1,25 +1,36 @@
 package route
 
 import (
+	shared "tracking-service/internal"
 	handler "tracking-service/internal/handlers"
 	middleware "tracking-service/internal/middlewares"
+	model "tracking-service/internal/models"
 	service "tracking-service/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
 type TenantRoutes struct {
-	service *service.ApplicationService
-	handler *handler.TenantHandler
+	config             *shared.Config
+	service            *service.ApplicationService
+	rate_limit_service *service.RateLimitService
+	usage_service      *service.UsageService
+	handler            *handler.TenantHandler
 }
 
 func NewTenantRoutes(
+	config *shared.Config,
 	service *service.ApplicationService,
+	rate_limit_service *service.RateLimitService,
+	usage_service *service.UsageService,
 	handler *handler.TenantHandler,
 ) *TenantRoutes {
 	return &TenantRoutes{
-		service: service,
-		handler: handler,
+		config:             config,
+		service:            service,
+		rate_limit_service: rate_limit_service,
+		usage_service:      usage_service,
+		handler:            handler,
 	}
 }
 
@@ -29,25 +40,55 @@ func (ur *TenantRoutes) RegisterRoutes(r *gin.Engine) {
 		middleware.TenantAuthMiddleware(ur.service),
 	)
 
-	group.GET("/platforms", ur.handler.GetPlatforms)
+	group.GET("/profile", middleware.RequireKeyType(shared.APIKeyTypeSecret), ur.handler.GetApp)
+	group.GET("/usage", middleware.RequireKeyType(shared.APIKeyTypeSecret), ur.handler.GetUsage)
+	group.GET("/audit-logs", middleware.RequireKeyType(shared.APIKeyTypeSecret), ur.handler.GetAuditLogs)
 
-	group.GET("/profile", ur.handler.GetApp)
+	analytics := group.Group("/analytics", middleware.RequireKeyType(shared.APIKeyTypeSecret))
+	analytics.GET("/events", ur.handler.GetEventAnalytics)
+	analytics.POST("/funnels", ur.handler.CreateFunnel)
+	analytics.POST("/retention", ur.handler.CreateRetention)
+	analytics.POST("/paths", ur.handler.CreatePath)
+	analytics.GET("/sessions", ur.handler.GetSessionAnalytics)
 
-	group.POST("/events", ur.handler.CreateEvent)
-	group.GET("/events/:event_id", ur.handler.GetEvent)
-	group.PUT("/events/:event_id", ur.handler.UpdateEvent)
-	group.DELETE("/events/:event_id", ur.handler.DeleteEvent)
-	group.GET("/events", ur.handler.GetEvents)
-	group.POST("/events/:event_id/fields", ur.handler.CreateEventField)
-	group.GET("/events/:event_id/fields/:field_id", ur.handler.GetEventField)
-	group.PUT("/events/:event_id/fields/:field_id", ur.handler.UpdateEventField)
-	group.DELETE("/events/:event_id/fields/:field_id", ur.handler.DeleteEventField)
+	schemaRead := group.Group("", middleware.RequireScope(shared.APIKeyScopeSchemaRead))
+	schemaRead.GET("/platforms", ur.handler.GetPlatforms)
+	schemaRead.GET("/events/:event_id", ur.handler.GetEvent)
+	schemaRead.GET("/events", ur.handler.GetEvents)
+	schemaRead.GET("/events/:event_id/fields/:field_id", ur.handler.GetEventField)
 
-	group.POST("/events/:event_id/logs", ur.handler.CreateEventLog)
+	schemaWrite := group.Group("", middleware.RequireScope(shared.APIKeyScopeSchemaWrite))
+	schemaWrite.POST("/events", ur.handler.CreateEvent)
+	schemaWrite.PUT("/events/:event_id", ur.handler.UpdateEvent)
+	schemaWrite.DELETE("/events/:event_id", ur.handler.DeleteEvent)
+	schemaWrite.POST("/events/:event_id/fields", ur.handler.CreateEventField)
+	schemaWrite.PUT("/events/:event_id/fields/:field_id", ur.handler.UpdateEventField)
+	schemaWrite.DELETE("/events/:event_id/fields/:field_id", ur.handler.DeleteEventField)
 
-	group.POST("/sessions", ur.handler.CreateSession)
-	group.GET("/sessions/:session_id", ur.handler.GetSession)
-	group.PUT("/sessions/:session_id", ur.handler.UpdateSession)
-	group.DELETE("/sessions/:session_id", ur.handler.DeleteSession)
+	ingest := group.Group("",
+		middleware.RequireScope(shared.APIKeyScopeIngest),
+	)
+	ingest.POST("/events/:event_id/logs",
+		middleware.RateLimitMiddleware(ur.rate_limit_service, middleware.SingleRequestCost),
+		middleware.QuotaMiddleware(ur.config, ur.usage_service, model.UsageMetricEventLogs, middleware.SingleRequestCost),
+		ur.handler.CreateEventLog,
+	)
+	// 對應 POST /tenant/event-logs:batch，以批次中的事件數量計算速率限制與配額
+	ingest.POST("/event-logs:action",
+		middleware.RequireAction("action", ":batch"),
+		middleware.RateLimitMiddleware(ur.rate_limit_service, middleware.EventLogBatchCost(ur.config.MaxRequestBodySize)),
+		middleware.QuotaMiddleware(ur.config, ur.usage_service, model.UsageMetricEventLogs, middleware.EventLogBatchCost(ur.config.MaxRequestBodySize)),
+		ur.handler.CreateEventLogBatch,
+	)
+
+	sessions := group.Group("",
+		middleware.RequireScope(shared.APIKeyScopeSessions),
+		middleware.RateLimitMiddleware(ur.rate_limit_service, middleware.SingleRequestCost),
+	)
+	sessions.POST("/sessions", middleware.QuotaMiddleware(ur.config, ur.usage_service, model.UsageMetricSessions, middleware.SingleRequestCost), ur.handler.CreateSession)
+	sessions.GET("/sessions", ur.handler.GetSessionByKey)
+	sessions.GET("/sessions/:session_id", ur.handler.GetSession)
+	sessions.PUT("/sessions/:session_id", ur.handler.UpdateSession)
+	sessions.DELETE("/sessions/:session_id", ur.handler.DeleteSession)
 
 }
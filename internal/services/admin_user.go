@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+	util "tracking-service/internal/utils"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	adminJWTIssuer = "tracking-service"
+	// adminJWTSecretMinLength 為 HS256 簽章密鑰的最小位元組數，較短時停用登入
+	adminJWTSecretMinLength = 32
+	// adminUserCacheSize 與 adminUserCacheTTL 為驗證 token 時快取的後台使用者，異動最晚在此時間後於其他節點生效
+	adminUserCacheSize = 1000
+	adminUserCacheTTL  = 30 * time.Second
+)
+
+// adminDummyPasswordHash 供查無使用者時比對，使登入失敗的回應時間一致
+var adminDummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("tracking-service"), bcrypt.DefaultCost)
+
+// AdminPrincipal 為通過驗證的後台身分，TenantID 為空字串代表可存取所有租戶
+type AdminPrincipal struct {
+	UserID   string
+	Email    string
+	Role     string
+	TenantID string
+}
+
+func (p *AdminPrincipal) HasRole(roles ...string) bool {
+	return slices.Contains(roles, p.Role)
+}
+
+func (p *AdminPrincipal) CanAccessTenant(tenantID string) bool {
+	return p.TenantID == "" || p.TenantID == tenantID
+}
+
+func (p *AdminPrincipal) AuditActor(clientIP string) *AuditActor {
+	if p.Role == shared.AdminRoleBootstrap {
+		return &AuditActor{Type: model.AuditActorBootstrap, ClientIP: clientIP}
+	}
+	return &AuditActor{Type: model.AuditActorAdminUser, ID: p.UserID, ClientIP: clientIP}
+}
+
+type adminClaims struct {
+	Role     string `json:"role"`
+	TenantID string `json:"tenant_id,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// AdminUserService 管理後台使用者並簽發、驗證登入 token，ADMIN_API_KEY 僅作為建立使用者的初始憑證
+type AdminUserService struct {
+	config      *shared.Config
+	snowflake   *snowflake.Node
+	repo        repository.AdminUserRepository
+	tenant_repo repository.TenantRepository
+	app_repo    repository.ApplicationRepository
+	users       *util.LRU[string, *model.AdminUser]
+}
+
+func NewAdminUserService(
+	config *shared.Config,
+	snowflake *snowflake.Node,
+	repo repository.AdminUserRepository,
+	tenant_repo repository.TenantRepository,
+	app_repo repository.ApplicationRepository,
+) *AdminUserService {
+	if config.AdminJWTSecret == "" {
+		log.Warn("ADMIN_JWT_SECRET is not set, admin login is disabled")
+	} else if len(config.AdminJWTSecret) < adminJWTSecretMinLength {
+		log.Errorf("ADMIN_JWT_SECRET must be at least %d bytes, admin login is disabled", adminJWTSecretMinLength)
+	}
+
+	return &AdminUserService{
+		config:      config,
+		snowflake:   snowflake,
+		repo:        repo,
+		tenant_repo: tenant_repo,
+		app_repo:    app_repo,
+		users:       util.NewLRU[string, *model.AdminUser](adminUserCacheSize, adminUserCacheTTL),
+	}
+}
+
+func (s *AdminUserService) CreateAdminUser(ctx context.Context, in *datastructure.CreateAdminUserRequest) (*model.AdminUser, error) {
+	tenantID, err := s.adminUserTenantID(ctx, in.Role, in.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(in.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errdefs.ErrorInternalError
+	}
+
+	now := time.Now()
+	user := &model.AdminUser{
+		ID:           s.snowflake.Generate().String(),
+		Email:        strings.ToLower(in.Email),
+		PasswordHash: string(passwordHash),
+		Role:         in.Role,
+		TenantID:     tenantID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.repo.CreateAdminUser(ctx, user); err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	return user, nil
+}
+
+func (s *AdminUserService) GetAdminUserByID(ctx context.Context, id string) (*model.AdminUser, error) {
+	user, err := s.repo.GetAdminUserByID(ctx, id)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+	return user, nil
+}
+
+func (s *AdminUserService) GetAdminUsers(ctx context.Context) ([]*model.AdminUser, error) {
+	users, err := s.repo.GetAdminUsers(ctx)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+	return users, nil
+}
+
+// UpdateAdminUser 更新 updated_at 後，先前簽發的 token 即失效
+func (s *AdminUserService) UpdateAdminUser(ctx context.Context, id string, in *datastructure.UpdateAdminUserRequest) error {
+	user, err := s.repo.GetAdminUserByID(ctx, id)
+	if err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	tenantID, err := s.adminUserTenantID(ctx, in.Role, in.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if in.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(in.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return errdefs.ErrorInternalError
+		}
+		user.PasswordHash = string(passwordHash)
+	}
+	user.Role = in.Role
+	user.TenantID = tenantID
+	user.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateAdminUser(ctx, user); err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	s.users.Delete(user.ID)
+	return nil
+}
+
+func (s *AdminUserService) DeleteAdminUser(ctx context.Context, id string) error {
+	user, err := s.repo.GetAdminUserByID(ctx, id)
+	if err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	if err := s.repo.DeleteAdminUser(ctx, user); err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	s.users.Delete(user.ID)
+	return nil
+}
+
+// Login 驗證帳號密碼後簽發 HS256 token，帳號不存在與密碼錯誤皆回傳未授權
+func (s *AdminUserService) Login(ctx context.Context, in *datastructure.AdminLoginRequest) (string, time.Time, *model.AdminUser, error) {
+	if !s.loginEnabled() {
+		return "", time.Time{}, nil, errdefs.ErrorForbidden
+	}
+
+	user, err := s.repo.GetAdminUserByEmail(ctx, in.Email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", time.Time{}, nil, errdefs.WrapGormError(err)
+		}
+		_ = bcrypt.CompareHashAndPassword(adminDummyPasswordHash, []byte(in.Password))
+		return "", time.Time{}, nil, errdefs.ErrorUnauthorized
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(in.Password)); err != nil {
+		log.WithContext(ctx).Warnf("Invalid password for admin user %s", user.ID)
+		return "", time.Time{}, nil, errdefs.ErrorUnauthorized
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.config.AdminJWTTTL)
+	claims := adminClaims{
+		Role: user.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        s.snowflake.Generate().String(),
+			Issuer:    adminJWTIssuer,
+			Subject:   user.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	if user.TenantID != nil {
+		claims.TenantID = *user.TenantID
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.config.AdminJWTSecret))
+	if err != nil {
+		return "", time.Time{}, nil, errdefs.ErrorInternalError
+	}
+
+	if err := s.repo.UpdateAdminUserLastLoginAt(ctx, user.ID, now); err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("Failed to update last login of admin user %s", user.ID)
+	}
+	user.LastLoginAt = &now
+
+	return token, expiresAt, user, nil
+}
+
+// loginEnabled 未設定 ADMIN_JWT_SECRET 或長度不足時停用登入與 token 驗證
+func (s *AdminUserService) loginEnabled() bool {
+	return len(s.config.AdminJWTSecret) >= adminJWTSecretMinLength
+}
+
+// Authenticate 驗證 token 後以資料庫中的使用者決定角色，使用者異動或刪除後 token 即失效
+func (s *AdminUserService) Authenticate(ctx context.Context, token string) (*AdminPrincipal, error) {
+	if !s.loginEnabled() {
+		return nil, errdefs.ErrorUnauthorized
+	}
+
+	var claims adminClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return []byte(s.config.AdminJWTSecret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(adminJWTIssuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, errdefs.ErrorUnauthorized
+	}
+
+	user, err := s.getAdminUser(ctx, claims.Subject)
+	if err != nil {
+		return nil, errdefs.ErrorUnauthorized
+	}
+	if claims.IssuedAt == nil || claims.IssuedAt.Before(user.UpdatedAt.Truncate(time.Second)) {
+		return nil, errdefs.ErrorUnauthorized
+	}
+
+	principal := &AdminPrincipal{
+		UserID: user.ID,
+		Email:  user.Email,
+		Role:   user.Role,
+	}
+	if user.TenantID != nil {
+		principal.TenantID = *user.TenantID
+	}
+	return principal, nil
+}
+
+// AuthenticateAPIKey 以 ADMIN_API_KEY 驗證，未設定時不接受
+func (s *AdminUserService) AuthenticateAPIKey(apiKey string) (*AdminPrincipal, error) {
+	if s.config.AdminApiKey == "" || subtle.ConstantTimeCompare([]byte(apiKey), []byte(s.config.AdminApiKey)) != 1 {
+		return nil, errdefs.ErrorUnauthorized
+	}
+	return &AdminPrincipal{Role: shared.AdminRoleBootstrap}, nil
+}
+
+func (s *AdminUserService) AuthorizeTenant(principal *AdminPrincipal, tenantID string) error {
+	if !principal.CanAccessTenant(tenantID) {
+		return errdefs.ErrorForbidden
+	}
+	return nil
+}
+
+func (s *AdminUserService) AuthorizeApplication(ctx context.Context, principal *AdminPrincipal, applicationID string) error {
+	if principal.TenantID == "" {
+		return nil
+	}
+
+	application, err := s.app_repo.GetApplicationByID(ctx, applicationID)
+	if err != nil {
+		return errdefs.WrapGormError(err)
+	}
+	return s.AuthorizeTenant(principal, application.TenantID)
+}
+
+func (s *AdminUserService) getAdminUser(ctx context.Context, id string) (*model.AdminUser, error) {
+	if user, ok := s.users.Get(id); ok {
+		return user, nil
+	}
+
+	user, err := s.repo.GetAdminUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.users.Set(id, user)
+	return user, nil
+}
+
+// adminUserTenantID 檢查角色與租戶的組合，super_admin 不可指定租戶，tenant_admin 必須指定租戶
+func (s *AdminUserService) adminUserTenantID(ctx context.Context, role string, tenantID *string) (*string, error) {
+	if tenantID != nil && *tenantID == "" {
+		tenantID = nil
+	}
+
+	switch role {
+	case shared.AdminRoleSuperAdmin:
+		if tenantID != nil {
+			return nil, errdefs.NewValidationError(map[string]string{
+				"tenant_id": "tenant_id must be empty for super_admin",
+			})
+		}
+		return nil, nil
+	case shared.AdminRoleTenantAdmin:
+		if tenantID == nil {
+			return nil, errdefs.NewValidationError(map[string]string{
+				"tenant_id": "tenant_id is required for tenant_admin",
+			})
+		}
+	}
+
+	if tenantID == nil {
+		return nil, nil
+	}
+	if _, err := s.tenant_repo.GetTenantByID(ctx, *tenantID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errdefs.NewValidationError(map[string]string{
+				"tenant_id": "tenant does not exist",
+			})
+		}
+		return nil, errdefs.WrapGormError(err)
+	}
+	return tenantID, nil
+}
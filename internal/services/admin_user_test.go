@@ -0,0 +1,29 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	shared "tracking-service/internal"
+)
+
+func TestAdminUserServiceLoginEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		want   bool
+	}{
+		{name: "empty", secret: "", want: false},
+		{name: "shorter than minimum", secret: strings.Repeat("s", adminJWTSecretMinLength-1), want: false},
+		{name: "minimum length", secret: strings.Repeat("s", adminJWTSecretMinLength), want: true},
+		{name: "longer than minimum", secret: strings.Repeat("s", 64), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &AdminUserService{config: &shared.Config{AdminJWTSecret: tt.secret}}
+			if got := s.loginEnabled(); got != tt.want {
+				t.Errorf("loginEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
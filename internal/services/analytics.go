@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// analyticsMaxBuckets 限制單次查詢的時間區間數量，例如以分鐘為區間最多查詢一天
+	analyticsMaxBuckets      = 1440
+	analyticsMaxProperties   = 10
+	analyticsMaxPropertyKey  = 255
+	analyticsBreakdownLimit  = 50
+	analyticsDefaultInterval = model.AnalyticsIntervalHour
+	funnelDefaultWindow      = 30 * time.Minute
+	retentionDefaultPeriods  = 7
+	// retentionMaxCohorts 限制 cohort 事件時間範圍內的週期數量
+	retentionMaxCohorts = 366
+	pathDefaultDepth    = 3
+	pathDefaultTopN     = 10
+)
+
+// AnalyticsService 查詢 ClickHouse 中的事件日誌，session 分析以 PostgreSQL 資料表引擎關聯 session
+type AnalyticsService struct {
+	repo       repository.AnalyticsRepository
+	event_repo repository.EventRepository
+}
+
+func NewAnalyticsService(
+	repo repository.AnalyticsRepository,
+	event_repo repository.EventRepository,
+) *AnalyticsService {
+	return &AnalyticsService{
+		repo:       repo,
+		event_repo: event_repo,
+	}
+}
+
+// GetEventMetrics 回傳整段時間的彙總與各時間區間的數量，分組時各取事件數最多的前 50 組
+func (s *AnalyticsService) GetEventMetrics(
+	ctx context.Context,
+	applicationID string,
+	in *datastructure.GetEventAnalyticsRequest,
+) ([]*model.EventMetric, []*model.EventMetric, error) {
+	eventLogQuery, err := toEventLogQuery(applicationID, &in.AnalyticsFilter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interval := in.Interval
+	if interval == "" {
+		interval = analyticsDefaultInterval
+	}
+	if buckets := eventLogQuery.To.Sub(eventLogQuery.From) / analyticsIntervalDuration(interval); buckets > analyticsMaxBuckets {
+		return nil, nil, errdefs.NewValidationError(map[string]string{
+			"interval": fmt.Sprintf("time range must contain at most %d %s buckets", analyticsMaxBuckets, interval),
+		})
+	}
+
+	query := &repository.EventMetricsQuery{
+		EventLogQuery:     *eventLogQuery,
+		BreakdownBy:       in.BreakdownBy,
+		BreakdownProperty: in.BreakdownProperty,
+		BreakdownLimit:    analyticsBreakdownLimit,
+	}
+	totals, err := s.repo.GetEventMetrics(ctx, query)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to query event metric totals")
+		return nil, nil, errdefs.ErrorInternalError
+	}
+
+	query.Interval = interval
+	series, err := s.repo.GetEventMetrics(ctx, query)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to query event metric series")
+		return nil, nil, errdefs.ErrorInternalError
+	}
+
+	return totals, series, nil
+}
+
+// GetFunnel 步驟的事件須屬於該應用程式，分組時取完成第一步人數最多的前 50 組，未帶入的時間窗與計算對象會填入預設值
+func (s *AnalyticsService) GetFunnel(
+	ctx context.Context,
+	applicationID string,
+	in *datastructure.CreateFunnelRequest,
+) ([]*model.FunnelResult, error) {
+	if in.WindowSeconds == 0 {
+		in.WindowSeconds = int64(funnelDefaultWindow.Seconds())
+	}
+	if in.CountBy == "" {
+		in.CountBy = model.AnalyticsCountBySession
+	}
+
+	query := &repository.FunnelQuery{
+		EventLogQuery: repository.EventLogQuery{
+			ApplicationID: applicationID,
+			From:          time.Unix(in.From, 0),
+			To:            time.Unix(in.To, 0),
+			PlatformID:    in.PlatformID,
+		},
+		Steps:             make([]*repository.EventCondition, 0, len(in.Steps)),
+		Window:            time.Duration(in.WindowSeconds) * time.Second,
+		CountBy:           in.CountBy,
+		BreakdownBy:       in.BreakdownBy,
+		BreakdownProperty: in.BreakdownProperty,
+		BreakdownLimit:    analyticsBreakdownLimit,
+	}
+	for i, step := range in.Steps {
+		if err := validateAnalyticsProperties(fmt.Sprintf("steps[%d].properties", i), step.Properties); err != nil {
+			return nil, err
+		}
+		if _, err := s.event_repo.GetEventByApplicationIDAndID(ctx, applicationID, step.EventID); err != nil {
+			return nil, errdefs.WrapGormError(err)
+		}
+		query.Steps = append(query.Steps, &repository.EventCondition{
+			EventID:    step.EventID,
+			Properties: step.Properties,
+		})
+	}
+
+	results, err := s.repo.GetFunnel(ctx, query)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to query funnel")
+		return nil, errdefs.ErrorInternalError
+	}
+	return results, nil
+}
+
+// GetRetention 事件須屬於該應用程式，未帶入的週期、週期數與匿名計算方式會填入預設值
+func (s *AnalyticsService) GetRetention(
+	ctx context.Context,
+	applicationID string,
+	in *datastructure.CreateRetentionRequest,
+) ([]*model.RetentionCohort, error) {
+	if in.Granularity == "" {
+		in.Granularity = model.AnalyticsGranularityDay
+	}
+	if in.Periods == 0 {
+		in.Periods = retentionDefaultPeriods
+	}
+	if in.Anonymous == "" {
+		in.Anonymous = model.AnalyticsAnonymousExclude
+	}
+
+	periodDuration := 24 * time.Hour
+	if in.Granularity == model.AnalyticsGranularityWeek {
+		periodDuration = 7 * 24 * time.Hour
+	}
+	if cohorts := time.Duration(in.To-in.From) * time.Second / periodDuration; cohorts > retentionMaxCohorts {
+		return nil, errdefs.NewValidationError(map[string]string{
+			"to": fmt.Sprintf("time range must contain at most %d %s cohorts", retentionMaxCohorts, in.Granularity),
+		})
+	}
+
+	events := map[string]*datastructure.RetentionEvent{
+		"cohort_event": in.CohortEvent,
+		"return_event": in.ReturnEvent,
+	}
+	for field, event := range events {
+		if err := validateAnalyticsProperties(field+".properties", event.Properties); err != nil {
+			return nil, err
+		}
+		if _, err := s.event_repo.GetEventByApplicationIDAndID(ctx, applicationID, event.EventID); err != nil {
+			return nil, errdefs.WrapGormError(err)
+		}
+	}
+
+	cohorts, err := s.repo.GetRetention(ctx, &repository.RetentionQuery{
+		EventLogQuery: repository.EventLogQuery{
+			ApplicationID: applicationID,
+			From:          time.Unix(in.From, 0),
+			To:            time.Unix(in.To, 0),
+			PlatformID:    in.PlatformID,
+		},
+		CohortEvent: &repository.EventCondition{
+			EventID:    in.CohortEvent.EventID,
+			Properties: in.CohortEvent.Properties,
+		},
+		ReturnEvent: &repository.EventCondition{
+			EventID:    in.ReturnEvent.EventID,
+			Properties: in.ReturnEvent.Properties,
+		},
+		Granularity: in.Granularity,
+		Periods:     in.Periods,
+		Anonymous:   in.Anonymous,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to query retention")
+		return nil, errdefs.ErrorInternalError
+	}
+	return cohorts, nil
+}
+
+// GetPaths 錨點事件須屬於該應用程式，未帶入的方向、深度與路徑數量會填入預設值
+func (s *AnalyticsService) GetPaths(
+	ctx context.Context,
+	applicationID string,
+	in *datastructure.CreatePathRequest,
+) (*model.PathGraph, error) {
+	if in.Direction == "" {
+		in.Direction = model.AnalyticsDirectionAfter
+	}
+	if in.Depth == 0 {
+		in.Depth = pathDefaultDepth
+	}
+	if in.TopN == 0 {
+		in.TopN = pathDefaultTopN
+	}
+
+	if err := validateAnalyticsProperties("properties", in.Properties); err != nil {
+		return nil, err
+	}
+	if _, err := s.event_repo.GetEventByApplicationIDAndID(ctx, applicationID, in.AnchorEventID); err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	result, err := s.repo.GetPaths(ctx, &repository.PathQuery{
+		EventLogQuery: repository.EventLogQuery{
+			ApplicationID: applicationID,
+			From:          time.Unix(in.From, 0),
+			To:            time.Unix(in.To, 0),
+			PlatformID:    in.PlatformID,
+		},
+		Anchor: &repository.EventCondition{
+			EventID:    in.AnchorEventID,
+			Properties: in.Properties,
+		},
+		Direction: in.Direction,
+		Depth:     in.Depth,
+		TopN:      in.TopN,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to query paths")
+		return nil, errdefs.ErrorInternalError
+	}
+	return toPathGraph(result, in.Direction), nil
+}
+
+// toPathGraph 合併各路徑中同一步數的相同事件為節點，節點依步數排序，同步數依 session 數由多至少排序
+func toPathGraph(result *model.PathResult, direction string) *model.PathGraph {
+	type nodeKey struct {
+		step    int
+		eventID string
+	}
+	type edgeKey struct {
+		source nodeKey
+		target nodeKey
+	}
+
+	nodeSessions := make(map[nodeKey]int64)
+	edgeSessions := make(map[edgeKey]int64)
+	for _, path := range result.Paths {
+		var previous nodeKey
+		for i, eventID := range path.Events {
+			key := nodeKey{step: i, eventID: eventID}
+			if direction == model.AnalyticsDirectionBefore {
+				key.step = -i
+			}
+			nodeSessions[key] += path.Sessions
+			if i > 0 {
+				edge := edgeKey{source: previous, target: key}
+				if direction == model.AnalyticsDirectionBefore {
+					edge = edgeKey{source: key, target: previous}
+				}
+				edgeSessions[edge] += path.Sessions
+			}
+			previous = key
+		}
+	}
+
+	keys := make([]nodeKey, 0, len(nodeSessions))
+	for key := range nodeSessions {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].step != keys[j].step {
+			return keys[i].step < keys[j].step
+		}
+		if nodeSessions[keys[i]] != nodeSessions[keys[j]] {
+			return nodeSessions[keys[i]] > nodeSessions[keys[j]]
+		}
+		return keys[i].eventID < keys[j].eventID
+	})
+
+	graph := &model.PathGraph{
+		Sessions: result.Sessions,
+		Nodes:    make([]*model.PathNode, 0, len(keys)),
+		Edges:    make([]*model.PathEdge, 0, len(edgeSessions)),
+	}
+	indexes := make(map[nodeKey]int, len(keys))
+	for i, key := range keys {
+		indexes[key] = i
+		graph.Nodes = append(graph.Nodes, &model.PathNode{
+			Step:     key.step,
+			EventID:  key.eventID,
+			Sessions: nodeSessions[key],
+		})
+	}
+	for edge, sessions := range edgeSessions {
+		graph.Edges = append(graph.Edges, &model.PathEdge{
+			Source:   indexes[edge.source],
+			Target:   indexes[edge.target],
+			Sessions: sessions,
+		})
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+		return graph.Edges[i].Target < graph.Edges[j].Target
+	})
+	return graph
+}
+
+// GetSessionMetrics 以開始時間在範圍內的 session 計算，回傳整體彙總與各分組的結果
+// 已結束的 session 以 ended_at 計算時長，尚未結束的以最後一筆事件時間推算，沒有事件時時長為 0
+func (s *AnalyticsService) GetSessionMetrics(
+	ctx context.Context,
+	applicationID string,
+	in *datastructure.GetSessionAnalyticsRequest,
+) (*model.SessionMetric, []*model.SessionMetric, error) {
+	totals, results, err := s.repo.GetSessionMetrics(ctx, &repository.SessionMetricsQuery{
+		ApplicationID: applicationID,
+		PlatformID:    in.PlatformID,
+		From:          time.Unix(in.From, 0),
+		To:            time.Unix(in.To, 0),
+		BreakdownBy:   in.BreakdownBy,
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("Failed to query session metrics")
+		return nil, nil, errdefs.ErrorInternalError
+	}
+
+	// 依日期分組時依時間排序，其他分組依 session 數由多至少排序
+	sort.Slice(results, func(i, j int) bool {
+		if in.BreakdownBy != model.AnalyticsBreakdownDay && results[i].Sessions != results[j].Sessions {
+			return results[i].Sessions > results[j].Sessions
+		}
+		return results[i].Breakdown < results[j].Breakdown
+	})
+	return totals, results, nil
+}
+
+func toEventLogQuery(applicationID string, in *datastructure.AnalyticsFilter) (*repository.EventLogQuery, error) {
+	if err := validateAnalyticsProperties("properties", in.Properties); err != nil {
+		return nil, err
+	}
+
+	return &repository.EventLogQuery{
+		ApplicationID: applicationID,
+		From:          time.Unix(in.From, 0),
+		To:            time.Unix(in.To, 0),
+		EventID:       in.EventID,
+		PlatformID:    in.PlatformID,
+		Properties:    in.Properties,
+	}, nil
+}
+
+func validateAnalyticsProperties(field string, properties map[string]string) error {
+	if len(properties) > analyticsMaxProperties {
+		return errdefs.NewValidationError(map[string]string{
+			field: fmt.Sprintf("%s must contain at most %d filters", field, analyticsMaxProperties),
+		})
+	}
+	for key := range properties {
+		if key == "" || len(key) > analyticsMaxPropertyKey {
+			return errdefs.NewValidationError(map[string]string{
+				field: fmt.Sprintf("property key must be 1 to %d characters", analyticsMaxPropertyKey),
+			})
+		}
+	}
+	return nil
+}
+
+func analyticsIntervalDuration(interval string) time.Duration {
+	switch interval {
+	case model.AnalyticsIntervalMinute:
+		return time.Minute
+	case model.AnalyticsIntervalDay:
+		return 24 * time.Hour
+	default:
+		return time.Hour
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	util "tracking-service/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// apiKeyCache 以密鑰摘要為 key 快取驗證結果，查無資料的結果另以較短的有效期快取
+type apiKeyCache struct {
+	found    *util.LRU[string, *apiKeyCacheEntry]
+	notFound *util.LRU[string, struct{}]
+	hits     metric.Int64Counter
+	misses   metric.Int64Counter
+}
+
+// apiKeyCacheEntry 會被多個請求共用，取出後不可修改
+type apiKeyCacheEntry struct {
+	application *model.Application
+	apiKey      *model.ApplicationApiKey
+}
+
+// newAPIKeyCache 容量為 0 時停用快取並回傳 nil
+func newAPIKeyCache(config *shared.Config) *apiKeyCache {
+	if config.ApiKeyCacheSize <= 0 {
+		return nil
+	}
+
+	meter := otel.Meter("tracking-service")
+	hits, err := meter.Int64Counter("api_key_cache.hits",
+		metric.WithDescription("Number of API key validations served from the in-process cache"))
+	if err != nil {
+		log.WithError(err).Error("failed to create api key cache hits counter")
+	}
+	misses, err := meter.Int64Counter("api_key_cache.misses",
+		metric.WithDescription("Number of API key validations that queried the database"))
+	if err != nil {
+		log.WithError(err).Error("failed to create api key cache misses counter")
+	}
+
+	return &apiKeyCache{
+		found:    util.NewLRU[string, *apiKeyCacheEntry](config.ApiKeyCacheSize, config.ApiKeyCacheTTL),
+		notFound: util.NewLRU[string, struct{}](config.ApiKeyCacheSize, config.ApiKeyNegativeCacheTTL),
+		hits:     hits,
+		misses:   misses,
+	}
+}
+
+// get 第二個回傳值代表是否命中，命中但 entry 為 nil 代表密鑰不存在
+func (c *apiKeyCache) get(ctx context.Context, keyHash string) (*apiKeyCacheEntry, bool) {
+	if entry, ok := c.found.Get(keyHash); ok {
+		c.hits.Add(ctx, 1, metric.WithAttributes(attribute.Bool("found", true)))
+		return entry, true
+	}
+	if _, ok := c.notFound.Get(keyHash); ok {
+		c.hits.Add(ctx, 1, metric.WithAttributes(attribute.Bool("found", false)))
+		return nil, true
+	}
+
+	c.misses.Add(ctx, 1)
+	return nil, false
+}
+
+func (c *apiKeyCache) set(keyHash string, entry *apiKeyCacheEntry) {
+	if entry == nil {
+		c.notFound.Set(keyHash, struct{}{})
+		return
+	}
+	c.found.Set(keyHash, entry)
+}
+
+func (c *apiKeyCache) evict(keyHashes []string) {
+	for _, keyHash := range keyHashes {
+		c.found.Delete(keyHash)
+		c.notFound.Delete(keyHash)
+	}
+}
+
+func (c *apiKeyCache) purge() {
+	c.found.Purge()
+	c.notFound.Purge()
+}
@@ -2,7 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
+	shared "tracking-service/internal"
 	datastructure "tracking-service/internal/datastructures"
 	errdefs "tracking-service/internal/errors"
 	model "tracking-service/internal/models"
@@ -10,26 +15,59 @@ import (
 	util "tracking-service/internal/utils"
 
 	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// apiKeyPrefixLength 為保存於資料庫、用於辨識密鑰的前綴長度
+	apiKeyPrefixLength = 8
+	// apiKeyLastUsedInterval 為更新密鑰 last_used_at 的最小間隔，避免每個請求都寫入資料庫
+	apiKeyLastUsedInterval  = time.Minute
+	publishableAPIKeyPrefix = "pk_"
+	// idleSessionBatchSize 為每批結束的閒置 session 數量
+	idleSessionBatchSize = 500
 )
 
 type ApplicationService struct {
+	config        *shared.Config
 	snowflake     *snowflake.Node
 	repo          repository.ApplicationRepository
 	tenant_repo   repository.TenantRepository
 	platform_repo repository.PlatformRepository
+	// 結束閒置 session 時由 ClickHouse 取得最後一筆事件時間
+	analytics_repo repository.AnalyticsRepository
+	usage_service  *UsageService
+	audit_service  *AuditService
+	key_cache      *apiKeyCache
+	// 近期已更新 last_used_at 的密鑰 ID
+	key_last_used *util.LRU[string, time.Time]
+	// session 結束、更新或刪除時清除事件寫入使用的快取
+	session_cache *SessionCache
 }
 
 func NewApplicationService(
+	config *shared.Config,
 	snowflake *snowflake.Node,
 	repo repository.ApplicationRepository,
 	tantent_repo repository.TenantRepository,
 	platform_repo repository.PlatformRepository,
+	analytics_repo repository.AnalyticsRepository,
+	usage_service *UsageService,
+	audit_service *AuditService,
+	session_cache *SessionCache,
 ) *ApplicationService {
 	return &ApplicationService{
-		snowflake:     snowflake,
-		repo:          repo,
-		tenant_repo:   tantent_repo,
-		platform_repo: platform_repo,
+		config:         config,
+		snowflake:      snowflake,
+		repo:           repo,
+		tenant_repo:    tantent_repo,
+		platform_repo:  platform_repo,
+		analytics_repo: analytics_repo,
+		usage_service:  usage_service,
+		audit_service:  audit_service,
+		key_cache:      newAPIKeyCache(config),
+		key_last_used:  util.NewLRU[string, time.Time](config.ApiKeyCacheSize, apiKeyLastUsedInterval),
+		session_cache:  session_cache,
 	}
 }
 
@@ -41,32 +79,48 @@ func (s *ApplicationService) CreateApplication(ctx context.Context, in *datastru
 
 	applicationID := s.snowflake.Generate().String()
 	now := time.Now()
-	apiKeyID := s.snowflake.Generate().String()
-	apiKey, err := util.GenerateAPIKey(32)
+	expiresAt, err := s.apiKeyExpiresAt("", now)
 	if err != nil {
-		return nil, errdefs.ErrorInternalError
+		return nil, err
+	}
+
+	apiKey, err := s.newApplicationApiKey(applicationID, shared.APIKeyTypeSecret, shared.APIKeyScopes, expiresAt, now)
+	if err != nil {
+		return nil, err
 	}
 
 	application := &model.Application{
-		ID:          applicationID,
-		TenantID:    tenant.ID,
-		Name:        in.Name,
-		Description: in.Description,
-		CreatedAt:   now,
-		ApiKeys: []model.ApplicationApiKey{
-			{
-				ID:            apiKeyID,
-				ApplicationID: applicationID,
-				APIKey:        apiKey,
-				CreatedAt:     now,
-			},
-		},
+		ID:                  applicationID,
+		TenantID:            tenant.ID,
+		Name:                in.Name,
+		Description:         in.Description,
+		AllowedOrigins:      normalizeOrigins(in.AllowedOrigins),
+		IngestionValidation: model.IngestionValidationReject,
+		CreatedAt:           now,
+		ApiKeys:             []model.ApplicationApiKey{*apiKey},
+	}
+	if rateLimit := toRateLimit(in.RateLimit); rateLimit != nil {
+		application.RateLimit = *rateLimit
+	}
+	if in.SessionTimeoutSeconds != nil {
+		application.SessionTimeoutSeconds = *in.SessionTimeoutSeconds
+	}
+	if in.IngestionValidation != nil {
+		application.IngestionValidation = *in.IngestionValidation
 	}
 
 	if err := s.repo.CreateApplication(ctx, application); err != nil {
 		return nil, errdefs.WrapGormError(err)
 	}
 
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionCreate,
+		ResourceType:  model.AuditResourceApplication,
+		ResourceID:    application.ID,
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		After:         auditApplication(application),
+	})
 	return application, nil
 }
 
@@ -83,12 +137,41 @@ func (s *ApplicationService) UpdateApplicationByID(ctx context.Context, id strin
 	if err != nil {
 		return errdefs.WrapGormError(err)
 	}
+	before := auditApplication(application)
 
 	application.Name = in.Name
 	application.Description = in.Description
+	if in.AllowedOrigins != nil {
+		application.AllowedOrigins = normalizeOrigins(in.AllowedOrigins)
+	}
+	if rateLimit := toRateLimit(in.RateLimit); rateLimit != nil {
+		application.RateLimit = *rateLimit
+	}
+	if in.SessionTimeoutSeconds != nil {
+		application.SessionTimeoutSeconds = *in.SessionTimeoutSeconds
+	}
+	if in.IngestionValidation != nil {
+		application.IngestionValidation = *in.IngestionValidation
+	}
 	application.UpdatedAt = time.Now()
 
-	return s.repo.UpdateApplication(ctx, application)
+	if err := s.repo.UpdateApplication(ctx, application); err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionUpdate,
+		ResourceType:  model.AuditResourceApplication,
+		ResourceID:    application.ID,
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		Before:        before,
+		After:         auditApplication(application),
+	})
+
+	// 快取中的應用程式帶有來源允許清單與速率限制，需一併清除
+	s.invalidateAPIKeys(ctx, application.ApiKeys...)
+	return nil
 }
 
 func (s *ApplicationService) DeleteApplicationByID(ctx context.Context, id string) error {
@@ -97,24 +180,135 @@ func (s *ApplicationService) DeleteApplicationByID(ctx context.Context, id strin
 		return errdefs.WrapGormError(err)
 	}
 
-	return s.repo.DeleteApplication(ctx, application)
+	if err := s.repo.DeleteApplication(ctx, application); err != nil {
+		return err
+	}
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionDelete,
+		ResourceType:  model.AuditResourceApplication,
+		ResourceID:    application.ID,
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		Before:        auditApplication(application),
+	})
+
+	s.invalidateAPIKeys(ctx, application.ApiKeys...)
+	return nil
 }
 
-func (s *ApplicationService) GetApplications(ctx context.Context) ([]*model.Application, error) {
-	apps, err := s.repo.GetApplications(ctx)
+// GetApplications tenantID 非空字串時覆寫請求中的租戶篩選，回傳下一頁的 cursor
+func (s *ApplicationService) GetApplications(ctx context.Context, tenantID string, in *datastructure.GetApplicationsRequest) ([]*model.Application, string, error) {
+	filter, err := toListFilter(&in.ListRequest)
 	if err != nil {
-		return nil, errdefs.WrapGormError(err)
+		return nil, "", err
+	}
+	filter.TenantID = in.TenantID
+	if tenantID != "" {
+		filter.TenantID = tenantID
 	}
-	return apps, nil
+
+	apps, nextCursor, err := s.repo.GetApplications(ctx, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
+	}
+	return apps, nextCursor, nil
 }
 
-func (s *ApplicationService) ValidateAPIKey(ctx context.Context, apiKey string) (*model.Application, error) {
-	application, err := s.repo.GetApplicationByAPIKey(ctx, apiKey)
+// ValidateAPIKey 回傳密鑰所屬的應用程式與密鑰本身，供呼叫端檢查權限範圍，已過期或撤銷的密鑰視為未授權
+// 回傳的物件可能來自快取並由多個請求共用，呼叫端不可修改
+func (s *ApplicationService) ValidateAPIKey(ctx context.Context, apiKey string) (*model.Application, *model.ApplicationApiKey, error) {
+	application, applicationApiKey, err := s.getApplicationByAPIKeyHash(ctx, util.HashAPIKey(apiKey))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return application, nil
+	now := time.Now()
+	if !applicationApiKey.IsActive(now) {
+		return nil, nil, errdefs.ErrorUnauthorized
+	}
+
+	if _, ok := s.key_last_used.SetIfAbsent(applicationApiKey.ID, now); ok {
+		if err := s.repo.UpdateApplicationAPIKeyLastUsedAt(ctx, applicationApiKey.ID, now); err != nil {
+			log.WithContext(ctx).WithError(err).Warnf("Failed to update last used time of api key %s", applicationApiKey.ID)
+		}
+	}
+
+	return application, applicationApiKey, nil
+}
+
+// EvictAPIKeys 清除本機快取中的密鑰，供接收其他節點的異動通知時使用
+func (s *ApplicationService) EvictAPIKeys(keyHashes []string) {
+	if s.key_cache != nil {
+		s.key_cache.evict(keyHashes)
+	}
+}
+
+// PurgeAPIKeyCache 清除本機全部密鑰快取，用於可能漏接異動通知時
+func (s *ApplicationService) PurgeAPIKeyCache() {
+	if s.key_cache != nil {
+		s.key_cache.purge()
+	}
+}
+
+func (s *ApplicationService) getApplicationByAPIKeyHash(ctx context.Context, keyHash string) (*model.Application, *model.ApplicationApiKey, error) {
+	if s.key_cache == nil {
+		return s.repo.GetApplicationByAPIKeyHash(ctx, keyHash)
+	}
+
+	if entry, ok := s.key_cache.get(ctx, keyHash); ok {
+		if entry == nil {
+			return nil, nil, errdefs.ErrorNotFound
+		}
+		return entry.application, entry.apiKey, nil
+	}
+
+	application, applicationApiKey, err := s.repo.GetApplicationByAPIKeyHash(ctx, keyHash)
+	if err != nil {
+		// 僅快取查無資料的結果，資料庫錯誤不快取
+		if errdefs.WrapGormError(err) == errdefs.ErrorNotFound {
+			s.key_cache.set(keyHash, nil)
+		}
+		return nil, nil, err
+	}
+
+	s.key_cache.set(keyHash, &apiKeyCacheEntry{application: application, apiKey: applicationApiKey})
+	return application, applicationApiKey, nil
+}
+
+// invalidateAPIKeys 清除本機快取並通知其他節點
+func (s *ApplicationService) invalidateAPIKeys(ctx context.Context, apiKeys ...model.ApplicationApiKey) {
+	if len(apiKeys) == 0 {
+		return
+	}
+
+	keyHashes := make([]string, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		keyHashes = append(keyHashes, apiKey.KeyHash)
+	}
+
+	s.EvictAPIKeys(keyHashes)
+	if err := s.repo.NotifyAPIKeysInvalidated(ctx, keyHashes); err != nil {
+		log.WithContext(ctx).WithError(err).Warn("Failed to notify api key invalidation")
+	}
+}
+
+// AuthorizeOrigin publishable 密鑰須由允許清單中的來源發出，來源取自 Origin，缺少時改用 Referer
+func (s *ApplicationService) AuthorizeOrigin(application *model.Application, apiKey *model.ApplicationApiKey, origin string, referer string) error {
+	if apiKey.Type != shared.APIKeyTypePublishable {
+		return nil
+	}
+
+	if origin == "" && referer != "" {
+		if u, err := url.Parse(referer); err == nil && u.Host != "" {
+			origin = u.Scheme + "://" + u.Host
+		}
+	}
+
+	if origin == "" || !application.AllowsOrigin(origin) {
+		return errdefs.ErrorForbidden
+	}
+	return nil
 }
 
 func (s *ApplicationService) CreateSession(ctx context.Context, in *datastructure.Session) (*model.Session, error) {
@@ -123,6 +317,16 @@ func (s *ApplicationService) CreateSession(ctx context.Context, in *datastructur
 		return nil, errdefs.WrapGormError(err)
 	}
 
+	session, err := s.createSession(ctx, application, in)
+	if err != nil {
+		s.usage_service.Record(application.TenantID, application.ID, model.UsageMetricSessions, 0, 1)
+		return nil, err
+	}
+	s.usage_service.Record(application.TenantID, application.ID, model.UsageMetricSessions, 1, 0)
+	return session, nil
+}
+
+func (s *ApplicationService) createSession(ctx context.Context, application *model.Application, in *datastructure.Session) (*model.Session, error) {
 	platform, err := s.platform_repo.GetPlatformByID(ctx, in.PlatformID)
 	if err != nil {
 		return nil, errdefs.WrapGormError(err)
@@ -169,6 +373,14 @@ func (s *ApplicationService) GetSessionByApplicationIDAndID(ctx context.Context,
 	return session, nil
 }
 
+func (s *ApplicationService) GetSessionByApplicationIDAndKey(ctx context.Context, applicationID string, sessionKey string) (*model.Session, error) {
+	session, err := s.repo.GetSessionByApplicationIDAndKey(ctx, applicationID, sessionKey)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+	return session, nil
+}
+
 func (s *ApplicationService) UpdateSessionByApplicationIDAndID(ctx context.Context, applicationID string, id string, in *datastructure.UpdateSessionRequest) error {
 	session, err := s.repo.GetSessionByApplicationIDAndID(ctx, applicationID, id)
 	if err != nil {
@@ -189,7 +401,91 @@ func (s *ApplicationService) UpdateSessionByApplicationIDAndID(ctx context.Conte
 
 	session.UpdatedAt = time.Now()
 
-	return s.repo.UpdateSession(ctx, session)
+	if err := s.repo.UpdateSession(ctx, session); err != nil {
+		return err
+	}
+	s.session_cache.evict(session)
+	return nil
+}
+
+// ExpireIdleSessions 結束閒置超過逾時的 session，結束時間為最後一筆事件時間，回傳結束的數量
+// last_activity_at 的更新有間隔，ClickHouse 查詢失敗時以 last_activity_at 作為結束時間
+func (s *ApplicationService) ExpireIdleSessions(ctx context.Context) (int, error) {
+	ended := 0
+	for {
+		sessions, err := s.repo.GetIdleSessions(ctx, defaultSessionTimeout(s.config.SessionInactivityTimeout), time.Now(), idleSessionBatchSize)
+		if err != nil {
+			return ended, err
+		}
+
+		batchEnded := 0
+		for applicationID, appSessions := range groupSessionsByApplication(sessions) {
+			lastEvents := s.lastEventTimes(ctx, applicationID, appSessions)
+			for _, session := range appSessions {
+				endedAt := session.StartedAt
+				if session.LastActivityAt != nil && session.LastActivityAt.After(endedAt) {
+					endedAt = *session.LastActivityAt
+				}
+				if lastEvent, ok := lastEvents[session.ID]; ok && lastEvent.After(endedAt) {
+					endedAt = lastEvent
+				}
+
+				updated, err := s.repo.EndSession(ctx, session.ID, endedAt)
+				if err != nil {
+					return ended, err
+				}
+				if updated {
+					s.session_cache.evict(session)
+					batchEnded++
+				}
+			}
+		}
+		ended += batchEnded
+
+		// 未滿一批或整批皆未更新時結束，避免重複處理同一批 session
+		if len(sessions) < idleSessionBatchSize || batchEnded == 0 {
+			return ended, nil
+		}
+	}
+}
+
+// defaultSessionTimeout 逾時短於 sessionActivityInterval 時，仍在活動的 session 可能因 last_activity_at 尚未更新而被結束
+func defaultSessionTimeout(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return timeout
+	}
+	return max(timeout, sessionActivityInterval)
+}
+
+func (s *ApplicationService) lastEventTimes(ctx context.Context, applicationID string, sessions []*model.Session) map[string]time.Time {
+	sessionIDs := make([]string, 0, len(sessions))
+	from := sessions[0].StartedAt
+	for _, session := range sessions {
+		sessionIDs = append(sessionIDs, session.ID)
+		if session.StartedAt.Before(from) {
+			from = session.StartedAt
+		}
+	}
+
+	stats, err := s.analytics_repo.GetSessionEventStats(ctx, applicationID, sessionIDs, from)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("Failed to query last event time of idle sessions of application %s", applicationID)
+		return nil
+	}
+
+	lastEvents := make(map[string]time.Time, len(stats))
+	for sessionID, stat := range stats {
+		lastEvents[sessionID] = stat.LastEventAt
+	}
+	return lastEvents
+}
+
+func groupSessionsByApplication(sessions []*model.Session) map[string][]*model.Session {
+	groups := make(map[string][]*model.Session)
+	for _, session := range sessions {
+		groups[session.ApplicationID] = append(groups[session.ApplicationID], session)
+	}
+	return groups
 }
 
 func (s *ApplicationService) GetApplicationByTenantIDAndID(ctx context.Context, tenantID string, id string) (*model.Application, error) {
@@ -200,27 +496,59 @@ func (s *ApplicationService) GetApplicationByTenantIDAndID(ctx context.Context,
 	return application, nil
 }
 
-func (s *ApplicationService) CreateApplicationAPIKey(ctx context.Context, applicationID string) (*model.ApplicationApiKey, error) {
+// CreateApplicationAPIKey 未指定類型時為 secret，未指定權限範圍時授予該類型的全部權限，未指定到期時間時套用預設有效期
+func (s *ApplicationService) CreateApplicationAPIKey(ctx context.Context, applicationID string, in *datastructure.CreateApplicationAPIKeyRequest) (*model.ApplicationApiKey, error) {
 	application, err := s.repo.GetApplicationByID(ctx, applicationID)
 	if err != nil {
 		return nil, errdefs.WrapGormError(err)
 	}
 
-	key, err := util.GenerateAPIKey(32)
+	keyType := in.Type
+	if keyType == "" {
+		keyType = shared.APIKeyTypeSecret
+	}
+
+	allowedScopes := shared.APIKeyScopes
+	if keyType == shared.APIKeyTypePublishable {
+		allowedScopes = shared.PublishableAPIKeyScopes
+	}
+
+	scopes := in.Scopes
+	if len(scopes) == 0 {
+		scopes = allowedScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(allowedScopes, scope) {
+			return nil, errdefs.NewValidationError(map[string]string{
+				"scopes": fmt.Sprintf("%s keys cannot have scope %s", keyType, scope),
+			})
+		}
+	}
+
+	now := time.Now()
+	expiresAt, err := s.apiKeyExpiresAt(in.ExpiresAt, now)
 	if err != nil {
-		return nil, errdefs.ErrorInternalError
+		return nil, err
 	}
-	apiKey := &model.ApplicationApiKey{
-		ID:            s.snowflake.Generate().String(),
-		ApplicationID: application.ID,
-		APIKey:        key,
-		CreatedAt:     time.Now(),
+
+	apiKey, err := s.newApplicationApiKey(application.ID, keyType, scopes, expiresAt, now)
+	if err != nil {
+		return nil, err
 	}
 
 	err = s.repo.CreateApplicationAPIKey(ctx, apiKey)
 	if err != nil {
 		return nil, errdefs.WrapGormError(err)
 	}
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionCreate,
+		ResourceType:  model.AuditResourceAPIKey,
+		ResourceID:    apiKey.ID,
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		After:         auditAPIKey(apiKey),
+	})
 	return apiKey, nil
 }
 
@@ -234,7 +562,109 @@ func (s *ApplicationService) DeleteApplicationAPIKey(ctx context.Context, applic
 		return errdefs.ErrorInvalidRequest
 	}
 
-	return s.repo.DeleteApplicationAPIKey(ctx, apiKey)
+	if err := s.repo.DeleteApplicationAPIKey(ctx, apiKey); err != nil {
+		return err
+	}
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionDelete,
+		ResourceType:  model.AuditResourceAPIKey,
+		ResourceID:    apiKey.ID,
+		ApplicationID: apiKey.ApplicationID,
+		Before:        auditAPIKey(apiKey),
+	})
+
+	s.invalidateAPIKeys(ctx, *apiKey)
+	return nil
+}
+
+// RotateApplicationAPIKey 以相同權限範圍建立新密鑰，舊密鑰在寬限期內仍可使用，讓用戶端能不中斷地替換
+func (s *ApplicationService) RotateApplicationAPIKey(ctx context.Context, applicationID string, apiKeyID string, in *datastructure.RotateApplicationAPIKeyRequest) (*model.ApplicationApiKey, error) {
+	oldKey, err := s.repo.GetApplicationKeyByID(ctx, apiKeyID)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	if oldKey.ApplicationID != applicationID {
+		return nil, errdefs.ErrorInvalidRequest
+	}
+
+	now := time.Now()
+	if !oldKey.IsActive(now) {
+		return nil, errdefs.ErrorInvalidRequest
+	}
+
+	expiresAt, err := s.apiKeyExpiresAt(in.ExpiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	newKey, err := s.newApplicationApiKey(applicationID, oldKey.Type, oldKey.Scopes, expiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	before := auditAPIKey(oldKey)
+	graceEndsAt := now.Add(s.config.ApiKeyRotationGracePeriod)
+	if oldKey.ExpiresAt == nil || oldKey.ExpiresAt.After(graceEndsAt) {
+		oldKey.ExpiresAt = &graceEndsAt
+	}
+	oldKey.UpdatedAt = now
+
+	if err := s.repo.RotateApplicationAPIKey(ctx, oldKey, newKey); err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	after := auditAPIKey(oldKey)
+	after["replaced_by"] = newKey.ID
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionRotate,
+		ResourceType:  model.AuditResourceAPIKey,
+		ResourceID:    oldKey.ID,
+		ApplicationID: applicationID,
+		Before:        before,
+		After:         after,
+	})
+
+	s.invalidateAPIKeys(ctx, *oldKey)
+	return newKey, nil
+}
+
+// RevokeApplicationAPIKey 立即停用密鑰，保留紀錄供稽核
+func (s *ApplicationService) RevokeApplicationAPIKey(ctx context.Context, applicationID string, apiKeyID string) error {
+	apiKey, err := s.repo.GetApplicationKeyByID(ctx, apiKeyID)
+	if err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	if apiKey.ApplicationID != applicationID {
+		return errdefs.ErrorInvalidRequest
+	}
+
+	if apiKey.RevokedAt != nil {
+		return nil
+	}
+
+	before := auditAPIKey(apiKey)
+	now := time.Now()
+	apiKey.RevokedAt = &now
+	apiKey.UpdatedAt = now
+
+	if err := s.repo.UpdateApplicationAPIKey(ctx, apiKey); err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionRevoke,
+		ResourceType:  model.AuditResourceAPIKey,
+		ResourceID:    apiKey.ID,
+		ApplicationID: apiKey.ApplicationID,
+		Before:        before,
+		After:         auditAPIKey(apiKey),
+	})
+
+	s.invalidateAPIKeys(ctx, *apiKey)
+	return nil
 }
 
 func (s *ApplicationService) DeleteSessionByApplicationIDAndID(ctx context.Context, applicationID string, id string) error {
@@ -243,5 +673,63 @@ func (s *ApplicationService) DeleteSessionByApplicationIDAndID(ctx context.Conte
 		return errdefs.WrapGormError(err)
 	}
 
-	return s.repo.DeleteSession(ctx, session)
+	if err := s.repo.DeleteSession(ctx, session); err != nil {
+		return err
+	}
+	s.session_cache.evict(session)
+	return nil
+}
+
+// newApplicationApiKey 產生新密鑰，資料庫僅保存摘要與前綴，完整密鑰只在建立回應中出現一次
+func (s *ApplicationService) newApplicationApiKey(applicationID string, keyType string, scopes []string, expiresAt *time.Time, now time.Time) (*model.ApplicationApiKey, error) {
+	key, err := util.GenerateAPIKey(32)
+	if err != nil {
+		return nil, errdefs.ErrorInternalError
+	}
+
+	// publishable 密鑰會出現在網頁原始碼中，加上前綴方便辨識
+	if keyType == shared.APIKeyTypePublishable {
+		key = publishableAPIKeyPrefix + key
+	}
+
+	return &model.ApplicationApiKey{
+		ID:            s.snowflake.Generate().String(),
+		ApplicationID: applicationID,
+		KeyHash:       util.HashAPIKey(key),
+		KeyPrefix:     key[:apiKeyPrefixLength],
+		Type:          keyType,
+		Scopes:        slices.Compact(slices.Sorted(slices.Values(scopes))),
+		ExpiresAt:     expiresAt,
+		APIKey:        key,
+		CreatedAt:     now,
+	}, nil
+}
+
+// apiKeyExpiresAt 解析指定的到期時間，未指定時依設定的預設有效期計算，為 0 代表永不過期
+func (s *ApplicationService) apiKeyExpiresAt(expiresAt string, now time.Time) (*time.Time, error) {
+	if expiresAt == "" {
+		if s.config.ApiKeyDefaultTTL <= 0 {
+			return nil, nil
+		}
+		t := now.Add(s.config.ApiKeyDefaultTTL)
+		return &t, nil
+	}
+
+	t, err := util.ParseTimeDefaultFormat(expiresAt)
+	if err != nil || !t.After(now) {
+		return nil, errdefs.ErrorInvalidRequest
+	}
+	return &t, nil
+}
+
+// normalizeOrigins 統一小寫並移除結尾斜線與重複項目
+func normalizeOrigins(origins []string) []string {
+	normalized := make([]string, 0, len(origins))
+	for _, origin := range origins {
+		origin = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), "/")
+		if origin != "" && !slices.Contains(normalized, origin) {
+			normalized = append(normalized, origin)
+		}
+	}
+	return normalized
 }
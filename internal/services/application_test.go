@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+)
+
+func TestDefaultSessionTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{name: "disabled", timeout: 0, want: 0},
+		{name: "negative disabled", timeout: -time.Second, want: -time.Second},
+		{name: "shorter than activity interval", timeout: 10 * time.Second, want: sessionActivityInterval},
+		{name: "equal to activity interval", timeout: sessionActivityInterval, want: sessionActivityInterval},
+		{name: "longer than activity interval", timeout: 30 * time.Minute, want: 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultSessionTimeout(tt.timeout); got != tt.want {
+				t.Errorf("defaultSessionTimeout(%v) = %v, want %v", tt.timeout, got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeSessionRepository struct {
+	repository.ApplicationRepository
+	idle           []*model.Session
+	defaultTimeout time.Duration
+	ended          map[string]time.Time
+}
+
+func (r *fakeSessionRepository) GetIdleSessions(_ context.Context, defaultTimeout time.Duration, _ time.Time, _ int) ([]*model.Session, error) {
+	r.defaultTimeout = defaultTimeout
+	return r.idle, nil
+}
+
+// EndSession EndedAt 已有值的 session 視為已由其他節點結束
+func (r *fakeSessionRepository) EndSession(_ context.Context, id string, endedAt time.Time) (bool, error) {
+	for _, session := range r.idle {
+		if session.ID == id && session.EndedAt != nil {
+			return false, nil
+		}
+	}
+	r.ended[id] = endedAt
+	return true, nil
+}
+
+type fakeSessionEventStatsRepository struct {
+	repository.AnalyticsRepository
+	stats map[string]*model.SessionEventStats
+	err   error
+}
+
+func (r *fakeSessionEventStatsRepository) GetSessionEventStats(context.Context, string, []string, time.Time) (map[string]*model.SessionEventStats, error) {
+	return r.stats, r.err
+}
+
+func TestApplicationServiceExpireIdleSessions(t *testing.T) {
+	started := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	activity := started.Add(5 * time.Minute)
+
+	tests := []struct {
+		name         string
+		session      *model.Session
+		stats        map[string]*model.SessionEventStats
+		statsErr     error
+		cfgTimeout   time.Duration
+		wantTimeout  time.Duration
+		wantEnded    int
+		wantEndedAt  time.Time
+		wantNotEnded bool
+		wantEvicted  bool
+	}{
+		{
+			name:        "ended at last event after last activity",
+			session:     &model.Session{ID: "s-1", ApplicationID: "app-1", SessionKey: "key-1", StartedAt: started, LastActivityAt: &activity},
+			stats:       map[string]*model.SessionEventStats{"s-1": {Events: 3, LastEventAt: activity.Add(2 * time.Minute)}},
+			wantEnded:   1,
+			wantEndedAt: activity.Add(2 * time.Minute),
+			wantEvicted: true,
+		},
+		{
+			name:        "ended at last activity without events",
+			session:     &model.Session{ID: "s-1", ApplicationID: "app-1", StartedAt: started, LastActivityAt: &activity},
+			wantEnded:   1,
+			wantEndedAt: activity,
+			wantEvicted: true,
+		},
+		{
+			name:        "ended at start without activity",
+			session:     &model.Session{ID: "s-1", ApplicationID: "app-1", StartedAt: started},
+			wantEnded:   1,
+			wantEndedAt: started,
+			wantEvicted: true,
+		},
+		{
+			name:        "analytics failure falls back to last activity",
+			session:     &model.Session{ID: "s-1", ApplicationID: "app-1", StartedAt: started, LastActivityAt: &activity},
+			statsErr:    errors.New("clickhouse unavailable"),
+			wantEnded:   1,
+			wantEndedAt: activity,
+			wantEvicted: true,
+		},
+		{
+			name:         "already ended elsewhere",
+			session:      &model.Session{ID: "s-1", ApplicationID: "app-1", StartedAt: started, EndedAt: &activity},
+			wantEnded:    0,
+			wantNotEnded: true,
+		},
+		{
+			name:        "short default timeout clamped",
+			session:     &model.Session{ID: "s-1", ApplicationID: "app-1", StartedAt: started},
+			cfgTimeout:  10 * time.Second,
+			wantTimeout: sessionActivityInterval,
+			wantEnded:   1,
+			wantEndedAt: started,
+			wantEvicted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeSessionRepository{idle: []*model.Session{tt.session}, ended: make(map[string]time.Time)}
+			cache := NewSessionCache()
+			cache.set(tt.session)
+			s := &ApplicationService{
+				config:         &shared.Config{SessionInactivityTimeout: tt.cfgTimeout},
+				repo:           repo,
+				analytics_repo: &fakeSessionEventStatsRepository{stats: tt.stats, err: tt.statsErr},
+				session_cache:  cache,
+			}
+
+			ended, err := s.ExpireIdleSessions(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ended != tt.wantEnded {
+				t.Errorf("ended = %d, want %d", ended, tt.wantEnded)
+			}
+			if repo.defaultTimeout != tt.wantTimeout {
+				t.Errorf("default timeout = %v, want %v", repo.defaultTimeout, tt.wantTimeout)
+			}
+			if endedAt, ok := repo.ended[tt.session.ID]; ok != !tt.wantNotEnded || (ok && !endedAt.Equal(tt.wantEndedAt)) {
+				t.Errorf("ended at = %v (%v), want %v", endedAt, ok, tt.wantEndedAt)
+			}
+
+			_, cached := cache.get(tt.session.ApplicationID, tt.session.ID)
+			_, keyCached := cache.getKey(tt.session.ApplicationID, tt.session.SessionKey)
+			if tt.wantEvicted && (cached || keyCached) {
+				t.Error("session still cached after it was ended")
+			}
+			if !tt.wantEvicted && !cached {
+				t.Error("session evicted although it was not ended here")
+			}
+		})
+	}
+}
+
+type fakeUpdateApplicationRepository struct {
+	repository.ApplicationRepository
+	application *model.Application
+	updateErr   error
+}
+
+func (r *fakeUpdateApplicationRepository) GetApplicationByID(context.Context, string) (*model.Application, error) {
+	return r.application, nil
+}
+
+func (r *fakeUpdateApplicationRepository) UpdateApplication(context.Context, *model.Application) error {
+	return r.updateErr
+}
+
+func TestApplicationServiceUpdateApplicationByIDWrapsError(t *testing.T) {
+	tests := []struct {
+		name      string
+		updateErr error
+		wantErr   error
+	}{
+		{name: "duplicate key", updateErr: errors.New(`ERROR: duplicate key value violates unique constraint "applications_name_key"`), wantErr: errdefs.ErrorDuplicateKey},
+		{name: "database error", updateErr: errors.New("connection refused"), wantErr: errdefs.ErrorInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeUpdateApplicationRepository{application: &model.Application{ID: "app-1", TenantID: "tenant-1"}, updateErr: tt.updateErr}
+			s := NewApplicationService(&shared.Config{}, nil, repo, nil, nil, nil, nil, nil, nil)
+
+			err := s.UpdateApplicationByID(context.Background(), "app-1", &datastructure.Application{Name: "renamed"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateApplicationByID() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
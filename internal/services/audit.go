@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+
+	"github.com/bwmarrin/snowflake"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AuditActor 為異動設定的操作者，由驗證 middleware 放入 context
+type AuditActor struct {
+	Type     string
+	ID       string
+	ClientIP string
+}
+
+func WithAuditActor(ctx context.Context, actor *AuditActor) context.Context {
+	return context.WithValue(ctx, shared.AuditActorKey, actor)
+}
+
+// auditActorFromContext 未經驗證 middleware 的呼叫視為系統操作
+func auditActorFromContext(ctx context.Context) *AuditActor {
+	if actor, ok := ctx.Value(shared.AuditActorKey).(*AuditActor); ok {
+		return actor
+	}
+	return &AuditActor{Type: model.AuditActorSystem}
+}
+
+// AuditEntry TenantID 為空字串時以 ApplicationID 查詢所屬租戶
+type AuditEntry struct {
+	Action        string
+	ResourceType  string
+	ResourceID    string
+	TenantID      string
+	ApplicationID string
+	Before        model.JSONB
+	After         model.JSONB
+}
+
+// AuditService 記錄租戶、平台、應用程式、密鑰、事件與欄位的異動
+type AuditService struct {
+	snowflake *snowflake.Node
+	repo      repository.AuditLogRepository
+	app_repo  repository.ApplicationRepository
+}
+
+func NewAuditService(
+	snowflake *snowflake.Node,
+	repo repository.AuditLogRepository,
+	app_repo repository.ApplicationRepository,
+) *AuditService {
+	return &AuditService{
+		snowflake: snowflake,
+		repo:      repo,
+		app_repo:  app_repo,
+	}
+}
+
+// Record 於異動成功後呼叫，寫入失敗僅記錄錯誤不影響原操作
+func (s *AuditService) Record(ctx context.Context, entry *AuditEntry) {
+	// 請求結束後仍須完成寫入
+	ctx = context.WithoutCancel(ctx)
+	actor := auditActorFromContext(ctx)
+
+	before, after := entry.Before, entry.After
+	if before != nil && after != nil {
+		before, after = diffAuditSnapshots(before, after)
+	}
+
+	auditLog := &model.AuditLog{
+		ID:           s.snowflake.Generate().String(),
+		ActorType:    actor.Type,
+		ActorID:      actor.ID,
+		Action:       entry.Action,
+		ResourceType: entry.ResourceType,
+		ResourceID:   entry.ResourceID,
+		Before:       before,
+		After:        after,
+		ClientIP:     actor.ClientIP,
+		CreatedAt:    time.Now(),
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		auditLog.TraceID = spanContext.TraceID().String()
+	}
+	if entry.ApplicationID != "" {
+		auditLog.ApplicationID = &entry.ApplicationID
+	}
+	if tenantID := s.auditTenantID(ctx, entry); tenantID != "" {
+		auditLog.TenantID = &tenantID
+	}
+
+	if err := s.repo.CreateAuditLog(ctx, auditLog); err != nil {
+		log.WithContext(ctx).WithError(err).Errorf("Failed to write audit log for %s %s %s", entry.Action, entry.ResourceType, entry.ResourceID)
+	}
+}
+
+// GetAuditLogs tenantID 與 applicationID 非空字串時覆寫請求中的篩選條件
+func (s *AuditService) GetAuditLogs(
+	ctx context.Context,
+	tenantID string,
+	applicationID string,
+	in *datastructure.GetAuditLogsRequest,
+) ([]*model.AuditLog, string, error) {
+	filter := &repository.AuditLogFilter{
+		TenantID:      in.TenantID,
+		ApplicationID: in.ApplicationID,
+		ActorType:     in.ActorType,
+		ActorID:       in.ActorID,
+		Action:        in.Action,
+		ResourceType:  in.ResourceType,
+		ResourceID:    in.ResourceID,
+		Cursor:        in.Cursor,
+		Limit:         in.Limit,
+	}
+	if tenantID != "" {
+		filter.TenantID = tenantID
+	}
+	if applicationID != "" {
+		filter.ApplicationID = applicationID
+	}
+	if filter.Limit == 0 {
+		filter.Limit = listDefaultLimit
+	}
+	if in.From > 0 {
+		from := time.Unix(in.From, 0)
+		filter.From = &from
+	}
+	if in.To > 0 {
+		to := time.Unix(in.To, 0)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, "", errdefs.NewValidationError(map[string]string{
+			"to": "to must not be earlier than from",
+		})
+	}
+
+	auditLogs, nextCursor, err := s.repo.GetAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
+	}
+	return auditLogs, nextCursor, nil
+}
+
+func (s *AuditService) auditTenantID(ctx context.Context, entry *AuditEntry) string {
+	if entry.TenantID != "" || entry.ApplicationID == "" {
+		return entry.TenantID
+	}
+
+	application, err := s.app_repo.GetApplicationByID(ctx, entry.ApplicationID)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("Failed to resolve tenant of application %s for audit log", entry.ApplicationID)
+		return ""
+	}
+	return application.TenantID
+}
+
+// diffAuditSnapshots 僅保留前後不同的欄位
+func diffAuditSnapshots(before model.JSONB, after model.JSONB) (model.JSONB, model.JSONB) {
+	diffBefore := make(model.JSONB)
+	diffAfter := make(model.JSONB)
+	for key, value := range after {
+		if previous, ok := before[key]; !ok || !reflect.DeepEqual(previous, value) {
+			diffBefore[key] = before[key]
+			diffAfter[key] = value
+		}
+	}
+	return diffBefore, diffAfter
+}
+
+// 以下快照僅包含可公開的設定欄位，不含密鑰雜湊
+
+func auditTenant(tenant *model.Tenant) model.JSONB {
+	return model.JSONB{
+		"name":                     tenant.Name,
+		"description":              tenant.Description,
+		"rate_limit_per_second":    tenant.RateLimit.PerSecond,
+		"rate_limit_burst":         tenant.RateLimit.Burst,
+		"quota_monthly_event_logs": tenant.Quota.MonthlyEventLogs,
+		"quota_monthly_sessions":   tenant.Quota.MonthlySessions,
+	}
+}
+
+func auditPlatform(platform *model.Platform) model.JSONB {
+	return model.JSONB{
+		"name": platform.Name,
+	}
+}
+
+func auditApplication(application *model.Application) model.JSONB {
+	return model.JSONB{
+		"tenant_id":               application.TenantID,
+		"name":                    application.Name,
+		"description":             application.Description,
+		"allowed_origins":         []string(application.AllowedOrigins),
+		"rate_limit_per_second":   application.RateLimit.PerSecond,
+		"rate_limit_burst":        application.RateLimit.Burst,
+		"session_timeout_seconds": application.SessionTimeoutSeconds,
+		"ingestion_validation":    application.IngestionValidation,
+	}
+}
+
+func auditAPIKey(apiKey *model.ApplicationApiKey) model.JSONB {
+	return model.JSONB{
+		"key_prefix": apiKey.KeyPrefix,
+		"type":       apiKey.Type,
+		"scopes":     []string(apiKey.Scopes),
+		"expires_at": apiKey.ExpiresAt,
+		"revoked_at": apiKey.RevokedAt,
+	}
+}
+
+func auditEvent(event *model.Event) model.JSONB {
+	return model.JSONB{
+		"application_id": event.ApplicationID,
+		"platform_id":    event.PlatformID,
+		"name":           event.Name,
+		"description":    event.Description,
+		"is_active":      event.IsActive,
+	}
+}
+
+func auditEventField(field *model.EventField) model.JSONB {
+	return model.JSONB{
+		"event_id":    field.EventID,
+		"name":        field.Name,
+		"data_type":   field.DataType,
+		"is_required": field.IsRequired,
+		"description": field.Description,
+	}
+}
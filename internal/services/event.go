@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 	shared "tracking-service/internal"
@@ -15,33 +16,69 @@ import (
 
 	"github.com/IBM/sarama"
 	"github.com/bwmarrin/snowflake"
+	"github.com/dnwe/otelsarama"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/gorm"
+)
+
+const (
+	// sessionActivityInterval 為更新 session last_activity_at 的最小間隔，避免每筆事件都寫入資料庫
+	sessionActivityInterval  = time.Minute
+	sessionActivityCacheSize = 10000
+	eventCacheSize           = 1000
+	// 事件定義於本節點異動時立即清除，其他節點最多延遲 eventCacheTTL 生效
+	eventCacheTTL = 30 * time.Second
 )
 
 type EventService struct {
-	snowflake     *snowflake.Node
-	repo          repository.EventRepository
-	app_repo      repository.ApplicationRepository
-	platform_repo repository.PlatformRepository
-	event_repo    repository.EventRepository
-	producer      sarama.SyncProducer
+	config           *shared.Config
+	snowflake        *snowflake.Node
+	repo             repository.EventRepository
+	app_repo         repository.ApplicationRepository
+	platform_repo    repository.PlatformRepository
+	event_repo       repository.EventRepository
+	producer         sarama.SyncProducer
+	outbox_service   *OutboxService
+	idempotency_repo repository.IdempotencyRepository
+	usage_service    *UsageService
+	audit_service    *AuditService
+	session_cache    *SessionCache
+	// 寫入事件日誌時參照驗證使用的事件
+	events *util.LRU[string, *model.Event]
+	// 近期已更新 last_activity_at 的 session
+	session_activity *util.LRU[string, time.Time]
 }
 
 func NewEventService(
+	config *shared.Config,
 	snowflake *snowflake.Node,
 	repo repository.EventRepository,
 	app_repo repository.ApplicationRepository,
 	platform_repo repository.PlatformRepository,
 	event_repo repository.EventRepository,
 	producer sarama.SyncProducer,
+	outbox_service *OutboxService,
+	idempotency_repo repository.IdempotencyRepository,
+	usage_service *UsageService,
+	audit_service *AuditService,
+	session_cache *SessionCache,
 ) *EventService {
 	return &EventService{
-		snowflake:     snowflake,
-		repo:          repo,
-		app_repo:      app_repo,
-		platform_repo: platform_repo,
-		event_repo:    event_repo,
-		producer:      producer,
+		config:           config,
+		snowflake:        snowflake,
+		repo:             repo,
+		app_repo:         app_repo,
+		platform_repo:    platform_repo,
+		event_repo:       event_repo,
+		producer:         producer,
+		outbox_service:   outbox_service,
+		idempotency_repo: idempotency_repo,
+		usage_service:    usage_service,
+		audit_service:    audit_service,
+		session_cache:    session_cache,
+		events:           util.NewLRU[string, *model.Event](eventCacheSize, eventCacheTTL),
+		session_activity: util.NewLRU[string, time.Time](sessionActivityCacheSize, sessionActivityInterval),
 	}
 }
 
@@ -70,6 +107,14 @@ func (s *EventService) CreateEvent(ctx context.Context, in *datastructure.Event)
 		return nil, errdefs.WrapGormError(err)
 	}
 
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionCreate,
+		ResourceType:  model.AuditResourceEvent,
+		ResourceID:    event.ID,
+		TenantID:      application.TenantID,
+		ApplicationID: application.ID,
+		After:         auditEvent(event),
+	})
 	return event, nil
 }
 
@@ -87,12 +132,7 @@ func (s *EventService) UpdateEventByID(ctx context.Context, id string, in *datas
 		return errdefs.WrapGormError(err)
 	}
 
-	event.Name = in.Name
-	event.Description = in.Description
-	event.IsActive = in.IsActive
-	event.UpdatedAt = time.Now()
-
-	return s.repo.UpdateEvent(ctx, event)
+	return s.updateEvent(ctx, event, in)
 }
 
 func (s *EventService) DeleteEventByID(ctx context.Context, id string) error {
@@ -101,15 +141,22 @@ func (s *EventService) DeleteEventByID(ctx context.Context, id string) error {
 		return errdefs.WrapGormError(err)
 	}
 
-	return s.repo.DeleteEvent(ctx, event)
+	return s.deleteEvent(ctx, event)
 }
 
-func (s *EventService) GetEvents(ctx context.Context) ([]*model.Event, error) {
-	events, err := s.repo.GetEvents(ctx)
+// GetEvents tenantID 非空字串時僅回傳該租戶應用程式的事件，回傳下一頁的 cursor
+func (s *EventService) GetEvents(ctx context.Context, tenantID string, in *datastructure.GetEventsRequest) ([]*model.Event, string, error) {
+	filter, err := toEventListFilter(in)
 	if err != nil {
-		return nil, errdefs.WrapGormError(err)
+		return nil, "", err
 	}
-	return events, nil
+	filter.TenantID = tenantID
+
+	events, nextCursor, err := s.repo.GetEvents(ctx, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
+	}
+	return events, nextCursor, nil
 }
 
 func (s *EventService) CreateEventField(ctx context.Context, in *datastructure.EventField) (*model.EventField, error) {
@@ -131,7 +178,15 @@ func (s *EventService) CreateEventField(ctx context.Context, in *datastructure.E
 	if err := s.event_repo.CreateEventField(ctx, eventField); err != nil {
 		return nil, errdefs.WrapGormError(err)
 	}
+	s.forgetEvent(event)
 
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionCreate,
+		ResourceType:  model.AuditResourceEventField,
+		ResourceID:    eventField.ID,
+		ApplicationID: event.ApplicationID,
+		After:         auditEventField(eventField),
+	})
 	return eventField, nil
 }
 
@@ -144,35 +199,45 @@ func (s *EventService) GetEventFieldByEventIDAndID(ctx context.Context, eventID
 }
 
 func (s *EventService) UpdateEventFieldByEventIDAndID(ctx context.Context, eventID string, fieldID string, in *datastructure.EventField) error {
-	field, err := s.repo.GetEventFieldByEventIDAndID(ctx, eventID, fieldID)
+	event, err := s.repo.GetEventByID(ctx, eventID)
 	if err != nil {
 		return errdefs.WrapGormError(err)
 	}
 
-	field.Name = in.Name
-	field.DataType = in.DataType
-	field.Description = in.Description
-	field.IsRequired = in.IsRequired
-	field.UpdatedAt = time.Now()
+	field, err := s.repo.GetEventFieldByEventIDAndID(ctx, event.ID, fieldID)
+	if err != nil {
+		return errdefs.WrapGormError(err)
+	}
 
-	return s.repo.UpdateEventField(ctx, field)
+	return s.updateEventField(ctx, event, field, in)
 }
 
 func (s *EventService) DeleteEventFieldByEventIDAndID(ctx context.Context, eventID string, fieldID string) error {
-	field, err := s.repo.GetEventFieldByEventIDAndID(ctx, eventID, fieldID)
+	event, err := s.repo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return errdefs.WrapGormError(err)
+	}
+
+	field, err := s.repo.GetEventFieldByEventIDAndID(ctx, event.ID, fieldID)
 	if err != nil {
 		return errdefs.WrapGormError(err)
 	}
 
-	return s.repo.DeleteEventField(ctx, field)
+	return s.deleteEventField(ctx, event, field)
 }
 
-func (s *EventService) GetEventFields(ctx context.Context, eventID string) ([]*model.EventField, error) {
-	eventFields, err := s.repo.GetEventFields(ctx, eventID)
+// GetEventFields 回傳下一頁的 cursor
+func (s *EventService) GetEventFields(ctx context.Context, eventID string, in *datastructure.ListRequest) ([]*model.EventField, string, error) {
+	filter, err := toListFilter(in)
 	if err != nil {
-		return nil, errdefs.WrapGormError(err)
+		return nil, "", err
+	}
+
+	eventFields, nextCursor, err := s.repo.GetEventFields(ctx, eventID, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
 	}
-	return eventFields, nil
+	return eventFields, nextCursor, nil
 }
 
 func (s *EventService) GetEventByTenant(ctx context.Context, applicationID string, eventID string) (*model.Event, error) {
@@ -189,12 +254,7 @@ func (s *EventService) UpdateEventByTenant(ctx context.Context, applicationID st
 		return errdefs.WrapGormError(err)
 	}
 
-	event.Name = in.Name
-	event.Description = in.Description
-	event.IsActive = in.IsActive
-	event.UpdatedAt = time.Now()
-
-	return s.repo.UpdateEvent(ctx, event)
+	return s.updateEvent(ctx, event, in)
 }
 
 func (s *EventService) DeleteEventByTenant(ctx context.Context, applicationID string, eventID string) error {
@@ -203,7 +263,7 @@ func (s *EventService) DeleteEventByTenant(ctx context.Context, applicationID st
 		return errdefs.WrapGormError(err)
 	}
 
-	return s.repo.DeleteEvent(ctx, event)
+	return s.deleteEvent(ctx, event)
 }
 
 func (s *EventService) GetEventFieldByTenant(
@@ -242,13 +302,7 @@ func (s *EventService) UpdateEventFieldByTenant(
 		return errdefs.WrapGormError(err)
 	}
 
-	field.Name = in.Name
-	field.DataType = in.DataType
-	field.Description = in.Description
-	field.IsRequired = in.IsRequired
-	field.UpdatedAt = time.Now()
-
-	return s.repo.UpdateEventField(ctx, field)
+	return s.updateEventField(ctx, event, field, in)
 }
 
 func (s *EventService) DeleteEventFieldByTenant(
@@ -267,43 +321,140 @@ func (s *EventService) DeleteEventFieldByTenant(
 		return errdefs.WrapGormError(err)
 	}
 
-	return s.repo.DeleteEventField(ctx, field)
+	return s.deleteEventField(ctx, event, field)
+}
+
+func (s *EventService) updateEvent(ctx context.Context, event *model.Event, in *datastructure.Event) error {
+	before := auditEvent(event)
+	event.Name = in.Name
+	event.Description = in.Description
+	event.IsActive = in.IsActive
+	event.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateEvent(ctx, event); err != nil {
+		return err
+	}
+	s.forgetEvent(event)
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionUpdate,
+		ResourceType:  model.AuditResourceEvent,
+		ResourceID:    event.ID,
+		ApplicationID: event.ApplicationID,
+		Before:        before,
+		After:         auditEvent(event),
+	})
+	return nil
+}
+
+func (s *EventService) deleteEvent(ctx context.Context, event *model.Event) error {
+	if err := s.repo.DeleteEvent(ctx, event); err != nil {
+		return err
+	}
+	s.forgetEvent(event)
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionDelete,
+		ResourceType:  model.AuditResourceEvent,
+		ResourceID:    event.ID,
+		ApplicationID: event.ApplicationID,
+		Before:        auditEvent(event),
+	})
+	return nil
 }
 
-func (s *EventService) GetEventsByApplicationID(ctx context.Context, applicationID string) ([]*model.Event, error) {
-	events, err := s.repo.GetEventsByApplicationID(ctx, applicationID)
+func (s *EventService) updateEventField(ctx context.Context, event *model.Event, field *model.EventField, in *datastructure.EventField) error {
+	before := auditEventField(field)
+	field.Name = in.Name
+	field.DataType = in.DataType
+	field.Description = in.Description
+	field.IsRequired = in.IsRequired
+	field.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateEventField(ctx, field); err != nil {
+		return err
+	}
+	s.forgetEvent(event)
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionUpdate,
+		ResourceType:  model.AuditResourceEventField,
+		ResourceID:    field.ID,
+		ApplicationID: event.ApplicationID,
+		Before:        before,
+		After:         auditEventField(field),
+	})
+	return nil
+}
+
+func (s *EventService) deleteEventField(ctx context.Context, event *model.Event, field *model.EventField) error {
+	if err := s.repo.DeleteEventField(ctx, field); err != nil {
+		return err
+	}
+	s.forgetEvent(event)
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:        model.AuditActionDelete,
+		ResourceType:  model.AuditResourceEventField,
+		ResourceID:    field.ID,
+		ApplicationID: event.ApplicationID,
+		Before:        auditEventField(field),
+	})
+	return nil
+}
+
+// GetEventsByApplicationID 一併載入欄位，回傳下一頁的 cursor
+func (s *EventService) GetEventsByApplicationID(ctx context.Context, applicationID string, in *datastructure.GetEventsRequest) ([]*model.Event, string, error) {
+	filter, err := toEventListFilter(in)
 	if err != nil {
-		return nil, errdefs.WrapGormError(err)
+		return nil, "", err
+	}
+
+	events, nextCursor, err := s.repo.GetEventsByApplicationID(ctx, applicationID, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
 	}
-	return events, nil
+	return events, nextCursor, nil
 }
 
+// CreateEventLog 重複的 message_id 不計入用量
 func (s *EventService) CreateEventLog(ctx context.Context, in *datastructure.EventLog) (*model.EventLog, error) {
-	event, err := s.repo.GetEventByID(ctx, in.EventID)
+	eventLog, reserved, err := s.createEventLog(ctx, in)
 	if err != nil {
-		return nil, errdefs.WrapGormError(err)
+		s.usage_service.Record(in.TenantID, in.ApplicationID, model.UsageMetricEventLogs, 0, 1)
+		return nil, err
+	}
+	if reserved {
+		s.usage_service.Record(in.TenantID, in.ApplicationID, model.UsageMetricEventLogs, 1, 0)
 	}
+	return eventLog, nil
+}
 
-	eventLog := &model.EventLog{
-		ID:            s.snowflake.Generate().String(),
-		ApplicationID: in.ApplicationID,
-		SessionID:     in.SessionID,
-		EventID:       event.ID,
-		PlatformID:    in.PlatformID,
-		Properties:    in.Properties,
-		CreatedAt:     time.Now(),
+func (s *EventService) createEventLog(ctx context.Context, in *datastructure.EventLog) (*model.EventLog, bool, error) {
+	event, err := s.ingestionEvent(ctx, in.ApplicationID, in.EventID)
+	if err != nil {
+		return nil, false, err
 	}
 
-	// 傳送 kafka 資料，若失敗則降級直接儲存資料；異常資料同步由NiFi執行
-	msg, err := s.createKafkaMessage(eventLog)
+	eventLog, err := s.newEventLog(ctx, event, in)
 	if err != nil {
-		if err := s.repo.CreateEventLog(ctx, eventLog); err != nil {
-			return nil, errdefs.WrapGormError(err)
-		}
+		return nil, false, err
+	}
+
+	// 重複的 message_id 直接回傳先前建立的事件日誌，不再傳送
+	eventLog, reserved, err := s.reserveEventLog(ctx, eventLog)
+	if err != nil || !reserved {
+		return eventLog, false, err
+	}
 
-		return eventLog, nil
+	msg, err := s.createKafkaMessage(ctx, in.TenantID, eventLog)
+	if err != nil {
+		log.WithContext(ctx).Errorf("Failed to create kafka message: %v", err)
+		s.releaseEventLog(ctx, eventLog)
+		return nil, false, errdefs.ErrorInternalError
 	}
 
+	// 傳送 kafka 資料，若失敗則寫入 outbox 由 relay 背景重送
 	err = util.WithRetry(ctx, 3, func() error {
 		_, _, err := s.producer.SendMessage(msg)
 		return err
@@ -311,19 +462,398 @@ func (s *EventService) CreateEventLog(ctx context.Context, in *datastructure.Eve
 
 	if err != nil {
 		log.WithContext(ctx).Errorf("Failed to send message to kafka: %v", err)
-		if err := s.repo.CreateEventLog(ctx, eventLog); err != nil {
-			log.WithContext(ctx).Errorf("Failed to create event log: %v", err)
-			return nil, errdefs.WrapGormError(err)
+		if err := s.outbox_service.Enqueue(ctx, []*sarama.ProducerMessage{msg}); err != nil {
+			log.WithContext(ctx).Errorf("Failed to enqueue outbox message: %v", err)
+			s.releaseEventLog(ctx, eventLog)
+			return nil, false, err
 		}
 
-		return eventLog, nil
+		return eventLog, true, nil
 	}
 
 	log.WithContext(ctx).Infof("Successfully sent message to kafka: %v", msg)
+	return eventLog, true, nil
+}
+
+// ValidateEventLogBatchSize 須於逐筆驗證前呼叫，避免超過上限的批次先被處理
+func (s *EventService) ValidateEventLogBatchSize(size int) error {
+	if size > s.config.EventLogBatchMaxSize {
+		return errdefs.NewValidationError(map[string]string{
+			"events": fmt.Sprintf("events must contain at most %d items", s.config.EventLogBatchMaxSize),
+		})
+	}
+	return nil
+}
+
+// CreateEventLogBatch 逐筆驗證事件日誌後以單次 SendMessages 傳送，回傳與輸入同順序的結果與錯誤
+func (s *EventService) CreateEventLogBatch(
+	ctx context.Context,
+	applicationID string,
+	in []*datastructure.EventLog,
+) ([]*model.EventLog, []error, error) {
+	if err := s.ValidateEventLogBatchSize(len(in)); err != nil {
+		return nil, nil, err
+	}
+
+	eventLogs := make([]*model.EventLog, len(in))
+	errs := make([]error, len(in))
+	events := make(map[string]*model.Event)
+	eventErrs := make(map[string]error)
+	msgs := make([]*sarama.ProducerMessage, 0, len(in))
+	indices := make(map[*sarama.ProducerMessage]int, len(in))
+	duplicates := 0
+
+	for i, item := range in {
+		event, ok := events[item.EventID]
+		if !ok {
+			if err, ok := eventErrs[item.EventID]; ok {
+				errs[i] = err
+				continue
+			}
+			e, err := s.ingestionEvent(ctx, applicationID, item.EventID)
+			if err != nil {
+				eventErrs[item.EventID] = err
+				errs[i] = err
+				continue
+			}
+			events[item.EventID] = e
+			event = e
+		}
+
+		item.ApplicationID = applicationID
+		eventLog, err := s.newEventLog(ctx, event, item)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		eventLog, reserved, err := s.reserveEventLog(ctx, eventLog)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		eventLogs[i] = eventLog
+		if !reserved {
+			duplicates++
+			continue
+		}
+
+		msg, err := s.createKafkaMessage(ctx, item.TenantID, eventLog)
+		if err != nil {
+			log.WithContext(ctx).Errorf("Failed to create kafka message: %v", err)
+			s.releaseEventLog(ctx, eventLog)
+			eventLogs[i] = nil
+			errs[i] = errdefs.ErrorInternalError
+			continue
+		}
+		msgs = append(msgs, msg)
+		indices[msg] = i
+	}
+
+	if len(msgs) == 0 {
+		s.recordEventLogBatchUsage(applicationID, in, errs, duplicates)
+		return eventLogs, errs, nil
+	}
+
+	var failed []*sarama.ProducerMessage
+	if err := s.producer.SendMessages(msgs); err != nil {
+		log.WithContext(ctx).Errorf("Failed to send messages to kafka: %v", err)
+		var producerErrs sarama.ProducerErrors
+		if errors.As(err, &producerErrs) {
+			for _, producerErr := range producerErrs {
+				failed = append(failed, producerErr.Msg)
+			}
+		} else {
+			failed = msgs
+		}
+	}
+
+	// 傳送 kafka 失敗的資料寫入 outbox 由 relay 背景重送
+	if len(failed) > 0 {
+		if err := s.outbox_service.Enqueue(ctx, failed); err != nil {
+			log.WithContext(ctx).Errorf("Failed to enqueue outbox messages: %v", err)
+			for _, msg := range failed {
+				i := indices[msg]
+				s.releaseEventLog(ctx, eventLogs[i])
+				eventLogs[i] = nil
+				errs[i] = err
+			}
+		}
+	}
+
+	s.recordEventLogBatchUsage(applicationID, in, errs, duplicates)
+	return eventLogs, errs, nil
+}
+
+// recordEventLogBatchUsage 重複的 message_id 不計入用量
+func (s *EventService) recordEventLogBatchUsage(applicationID string, in []*datastructure.EventLog, errs []error, duplicates int) {
+	if len(in) == 0 {
+		return
+	}
+
+	rejected := 0
+	for _, err := range errs {
+		if err != nil {
+			rejected++
+		}
+	}
+	s.usage_service.Record(in[0].TenantID, applicationID, model.UsageMetricEventLogs, len(in)-rejected-duplicates, rejected)
+}
+
+func (s *EventService) newEventLog(ctx context.Context, event *model.Event, in *datastructure.EventLog) (*model.EventLog, error) {
+	properties, err := validateEventProperties(event.Fields, in.Properties, s.config.UnknownPropertyPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientTimestamp *time.Time
+	if in.ClientTimestamp != "" {
+		t, err := util.ParseTimeDefaultFormat(in.ClientTimestamp)
+		if err != nil {
+			return nil, errdefs.ErrorInvalidRequest
+		}
+		clientTimestamp = &t
+	}
+
+	// 通過驗證後才建立 session，避免無效事件留下空的 session
+	if in.SessionID == "" {
+		sessionID, err := s.resolveSessionKey(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		in.SessionID = sessionID
+	}
+
+	session, violations, err := s.checkReferences(ctx, event, in)
+	if err != nil {
+		return nil, err
+	}
+
+	eventLog := &model.EventLog{
+		ID:              s.snowflake.Generate().String(),
+		MessageID:       in.MessageID,
+		ApplicationID:   in.ApplicationID,
+		SessionID:       in.SessionID,
+		EventID:         event.ID,
+		PlatformID:      in.PlatformID,
+		Properties:      properties,
+		ClientTimestamp: clientTimestamp,
+		CreatedAt:       time.Now(),
+	}
+	for _, violation := range violations {
+		eventLog.Violations = append(eventLog.Violations, errdefs.ErrorCode(violation))
+	}
+	if session != nil {
+		eventLog.UserID = session.UserID
+		s.touchSession(ctx, in.ApplicationID, in.SessionID, eventLog.CreatedAt)
+	}
 	return eventLog, nil
 }
 
+// checkReferences 驗證事件為啟用中且平台相符、session 存在且尚未結束
+// 應用程式設定為 tag 時回傳違反項目而不拒絕，session 不存在時回傳 nil
+func (s *EventService) checkReferences(ctx context.Context, event *model.Event, in *datastructure.EventLog) (*model.Session, []error, error) {
+	var violations []error
+	if !event.IsActive {
+		violations = append(violations, errdefs.ErrorEventInactive)
+	}
+	if event.PlatformID != 0 && event.PlatformID != in.PlatformID {
+		violations = append(violations, errdefs.ErrorEventPlatformMismatch)
+	}
+
+	session, err := s.ingestionSession(ctx, in.ApplicationID, in.SessionID)
+	switch {
+	case errors.Is(err, errdefs.ErrorSessionNotFound):
+		violations = append(violations, err)
+	case err != nil:
+		return nil, nil, err
+	case session.EndedAt != nil:
+		violations = append(violations, errdefs.ErrorSessionEnded)
+	}
+
+	if len(violations) > 0 && in.IngestionValidation != model.IngestionValidationTag {
+		return nil, nil, violations[0]
+	}
+	return session, violations, nil
+}
+
+// ingestionEvent 取得應用程式下的事件與欄位定義
+func (s *EventService) ingestionEvent(ctx context.Context, applicationID string, eventID string) (*model.Event, error) {
+	key := applicationID + ":" + eventID
+	if event, ok := s.events.Get(key); ok {
+		return event, nil
+	}
+
+	event, err := s.repo.GetEventByApplicationIDAndID(ctx, applicationID, eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errdefs.ErrorEventNotFound
+	}
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	s.events.Set(key, event)
+	return event, nil
+}
+
+func (s *EventService) forgetEvent(event *model.Event) {
+	s.events.Delete(event.ApplicationID + ":" + event.ID)
+}
+
+// resolveSessionKey 取得 session_key 對應的 session，不存在時以請求的平台、User-Agent 與 IP 建立，已結束時重新開啟
+func (s *EventService) resolveSessionKey(ctx context.Context, in *datastructure.EventLog) (string, error) {
+	// 快取的 session 已結束時改由資料庫取得並重新開啟
+	if sessionID, ok := s.session_cache.getKey(in.ApplicationID, in.SessionKey); ok {
+		if session, ok := s.session_cache.get(in.ApplicationID, sessionID); ok && session.EndedAt == nil {
+			return sessionID, nil
+		}
+	}
+
+	session, err := s.app_repo.GetSessionByApplicationIDAndKey(ctx, in.ApplicationID, in.SessionKey)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		session, err = s.createKeySession(ctx, in)
+		if err != nil {
+			return "", err
+		}
+	case err != nil:
+		return "", errdefs.WrapGormError(err)
+	case session.EndedAt != nil:
+		now := time.Now()
+		session.EndedAt = nil
+		session.LastActivityAt = &now
+		session.UpdatedAt = now
+		if err := s.app_repo.UpdateSession(ctx, session); err != nil {
+			return "", errdefs.WrapGormError(err)
+		}
+	}
+
+	s.session_cache.set(session)
+	return session.ID, nil
+}
+
+// createKeySession 與 CreateSession 相同須通過 session 配額，同時建立相同 session_key 時以先建立的 session 為準
+func (s *EventService) createKeySession(ctx context.Context, in *datastructure.EventLog) (*model.Session, error) {
+	decision, err := s.usage_service.CheckQuota(ctx, in.TenantID, model.UsageMetricSessions, 1)
+	if err != nil {
+		// 無法取得配額時放行，避免影響事件寫入
+		log.WithContext(ctx).WithError(err).Warnf("Failed to check quota for tenant %s", in.TenantID)
+	} else if !decision.Allowed {
+		s.usage_service.Record(in.TenantID, in.ApplicationID, model.UsageMetricSessions, 0, 1)
+		return nil, errdefs.ErrorQuotaExceeded
+	}
+
+	platform, err := s.platform_repo.GetPlatformByID(ctx, in.PlatformID)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	now := time.Now()
+	session := &model.Session{
+		ID:             s.snowflake.Generate().String(),
+		ApplicationID:  in.ApplicationID,
+		PlatformID:     platform.ID,
+		SessionKey:     in.SessionKey,
+		StartedAt:      now,
+		LastActivityAt: &now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if in.UserAgent != "" {
+		session.UserAgent = &in.UserAgent
+	}
+	if in.IPAddress != "" {
+		session.IPAddress = &in.IPAddress
+	}
+
+	if err := s.app_repo.CreateSession(ctx, session); err != nil {
+		existing, getErr := s.app_repo.GetSessionByApplicationIDAndKey(ctx, in.ApplicationID, in.SessionKey)
+		if getErr != nil {
+			s.usage_service.Record(in.TenantID, in.ApplicationID, model.UsageMetricSessions, 0, 1)
+			return nil, errdefs.WrapGormError(err)
+		}
+		return existing, nil
+	}
+	s.usage_service.Record(in.TenantID, in.ApplicationID, model.UsageMetricSessions, 1, 0)
+	return session, nil
+}
+
+// ingestionSession 取得應用程式下的 session，其使用者 ID 供分析計算不重複使用者
+func (s *EventService) ingestionSession(ctx context.Context, applicationID string, sessionID string) (*model.Session, error) {
+	if session, ok := s.session_cache.get(applicationID, sessionID); ok {
+		return session, nil
+	}
+
+	session, err := s.app_repo.GetSessionByApplicationIDAndID(ctx, applicationID, sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errdefs.ErrorSessionNotFound
+	}
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	s.session_cache.set(session)
+	return session, nil
+}
+
+// touchSession 更新 session 的最後活動時間供閒置逾時判斷，寫入失敗僅記錄錯誤
+func (s *EventService) touchSession(ctx context.Context, applicationID string, sessionID string, now time.Time) {
+	if _, ok := s.session_activity.SetIfAbsent(applicationID+":"+sessionID, now); !ok {
+		return
+	}
+	if err := s.app_repo.UpdateSessionLastActivityAt(ctx, applicationID, sessionID, now); err != nil {
+		log.WithContext(ctx).WithError(err).Warnf("Failed to update last activity time of session %s", sessionID)
+	}
+}
+
+// reserveEventLog 以 message_id 於冪等期間內去重，重複時回傳先前建立的事件日誌與 false
+func (s *EventService) reserveEventLog(ctx context.Context, eventLog *model.EventLog) (*model.EventLog, bool, error) {
+	if eventLog.MessageID == "" {
+		return eventLog, true, nil
+	}
+
+	payload, err := json.Marshal(eventLog)
+	if err != nil {
+		return nil, false, errdefs.ErrorInternalError
+	}
+
+	key := &model.IdempotencyKey{
+		ApplicationID: eventLog.ApplicationID,
+		MessageID:     eventLog.MessageID,
+		EventLogID:    eventLog.ID,
+		EventLog:      payload,
+		ExpiresAt:     eventLog.CreatedAt.Add(s.config.IdempotencyWindow),
+		CreatedAt:     eventLog.CreatedAt,
+	}
+	existing, reserved, err := s.idempotency_repo.ReserveIdempotencyKey(ctx, key)
+	if err != nil {
+		return nil, false, errdefs.WrapGormError(err)
+	}
+	if reserved {
+		return eventLog, true, nil
+	}
+
+	var original model.EventLog
+	if err := json.Unmarshal(existing.EventLog, &original); err != nil {
+		return nil, false, errdefs.ErrorInternalError
+	}
+	log.WithContext(ctx).Infof("Skip duplicate event log with message id %s", eventLog.MessageID)
+	return &original, false, nil
+}
+
+// releaseEventLog 事件日誌未能送出時釋放 message_id，讓用戶端可以重試
+func (s *EventService) releaseEventLog(ctx context.Context, eventLog *model.EventLog) {
+	if eventLog.MessageID == "" {
+		return
+	}
+	if err := s.idempotency_repo.DeleteIdempotencyKey(ctx, eventLog.ApplicationID, eventLog.MessageID); err != nil {
+		log.WithContext(ctx).Errorf("Failed to release idempotency key %s: %v", eventLog.MessageID, err)
+	}
+}
+
 func (s *EventService) createKafkaMessage(
+	ctx context.Context,
+	tenantID string,
 	queue *model.EventLog,
 ) (*sarama.ProducerMessage, error) {
 	jsonData, err := json.Marshal(queue)
@@ -331,12 +861,47 @@ func (s *EventService) createKafkaMessage(
 		return nil, fmt.Errorf("marshal queue failed: %w", err)
 	}
 
-	driverTraceId := s.snowflake.Generate().String()
 	msg := &sarama.ProducerMessage{
 		Topic: shared.KafkaTopic,
-		Key:   sarama.StringEncoder(driverTraceId),
+		Key:   sarama.StringEncoder(s.partitionKey(queue)),
 		Value: sarama.ByteEncoder(jsonData),
-	}
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(shared.KafkaHeaderTenantID), Value: []byte(tenantID)},
+			{Key: []byte(shared.KafkaHeaderApplicationID), Value: []byte(queue.ApplicationID)},
+			{Key: []byte(shared.KafkaHeaderEventID), Value: []byte(queue.EventID)},
+			{Key: []byte(shared.KafkaHeaderSchemaVersion), Value: []byte(shared.EventLogSchemaVersion)},
+		},
+	}
+	// 用戶端提供 message_id 時放入標頭，供下游去重
+	if queue.MessageID != "" {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{
+			Key:   []byte(shared.KafkaHeaderMessageID),
+			Value: []byte(queue.MessageID),
+		})
+	}
+	// 寫入請求的 W3C traceparent，producer 的 span 會以此為 parent
+	propagation.TraceContext{}.Inject(ctx, otelsarama.NewProducerMessageCarrier(msg))
 
 	return msg, nil
 }
+
+// partitionKey 依設定決定訊息 key，預設以 session 分區以保留同一 session 內的事件順序
+// 僅 random 策略以 message_id 作為 key，讓重送的同一則訊息落在同一分區供下游去重；
+// 其他策略的 key 取自事件本身的欄位，重送時本就相同，且須以 session／應用程式／使用者維持順序，故不使用 message_id
+func (s *EventService) partitionKey(queue *model.EventLog) string {
+	switch s.config.KafkaPartitionKey {
+	case shared.KafkaPartitionRandom:
+		if queue.MessageID != "" {
+			return queue.MessageID
+		}
+		return s.snowflake.Generate().String()
+	case shared.KafkaPartitionByApplication:
+		return queue.ApplicationID
+	case shared.KafkaPartitionByUser:
+		// user_id 取自 session，不同應用程式的 user_id 可能相同，須加上應用程式 ID；session 尚未綁定使用者時退回以 session 分區
+		if queue.UserID != nil && *queue.UserID != "" {
+			return queue.ApplicationID + ":" + *queue.UserID
+		}
+	}
+	return queue.SessionID
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"time"
+	shared "tracking-service/internal"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	util "tracking-service/internal/utils"
+)
+
+// validateEventProperties 依據事件欄位定義檢查必填與資料型別，並依設定處理未定義的屬性
+func validateEventProperties(
+	fields []*model.EventField,
+	properties map[string]interface{},
+	unknownPolicy string,
+) (map[string]interface{}, error) {
+	details := make(map[string]string)
+	result := make(map[string]interface{}, len(properties))
+
+	defined := make(map[string]*model.EventField, len(fields))
+	for _, field := range fields {
+		defined[field.Name] = field
+
+		value, ok := properties[field.Name]
+		if !ok || value == nil {
+			if field.IsRequired {
+				details[field.Name] = fmt.Sprintf("%s is required", field.Name)
+			}
+			continue
+		}
+
+		if !matchDataType(field.DataType, value) {
+			details[field.Name] = fmt.Sprintf("%s must be of type %s", field.Name, field.DataType)
+			continue
+		}
+		result[field.Name] = value
+	}
+
+	for key, value := range properties {
+		if _, ok := defined[key]; ok {
+			continue
+		}
+		switch unknownPolicy {
+		case shared.UnknownPropertyReject:
+			details[key] = fmt.Sprintf("%s is not a defined field", key)
+		case shared.UnknownPropertyStrip:
+			// 直接略過未定義的屬性
+		default:
+			result[key] = value
+		}
+	}
+
+	if len(details) > 0 {
+		return nil, errdefs.NewValidationError(details)
+	}
+
+	return result, nil
+}
+
+func matchDataType(dataType string, value interface{}) bool {
+	switch dataType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "int":
+		switch v := value.(type) {
+		case float64:
+			return v == math.Trunc(v)
+		case int, int32, int64:
+			return true
+		}
+		return false
+	case "float":
+		switch value.(type) {
+		case float64, float32, int, int32, int64:
+			return true
+		}
+		return false
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "datetime":
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		if _, err := util.ParseTimeDefaultFormat(s); err == nil {
+			return true
+		}
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	case "json":
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return true
+		}
+		return false
+	}
+
+	// 未知型別不做限制
+	return true
+}
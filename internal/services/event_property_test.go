@@ -0,0 +1,131 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	shared "tracking-service/internal"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+)
+
+func TestValidateEventProperties(t *testing.T) {
+	fields := []*model.EventField{
+		{Name: "plan", DataType: "string", IsRequired: true},
+		{Name: "amount", DataType: "float"},
+		{Name: "quantity", DataType: "int"},
+	}
+
+	tests := []struct {
+		name          string
+		properties    map[string]interface{}
+		unknownPolicy string
+		want          map[string]interface{}
+		wantDetails   []string
+	}{
+		{
+			name:       "valid",
+			properties: map[string]interface{}{"plan": "pro", "amount": 9.5, "quantity": float64(2)},
+			want:       map[string]interface{}{"plan": "pro", "amount": 9.5, "quantity": float64(2)},
+		},
+		{
+			name:        "missing required field",
+			properties:  map[string]interface{}{"amount": 9.5},
+			wantDetails: []string{"plan"},
+		},
+		{
+			name:        "null required field",
+			properties:  map[string]interface{}{"plan": nil},
+			wantDetails: []string{"plan"},
+		},
+		{
+			name:       "optional field omitted",
+			properties: map[string]interface{}{"plan": "pro"},
+			want:       map[string]interface{}{"plan": "pro"},
+		},
+		{
+			name:        "wrong types reported per field",
+			properties:  map[string]interface{}{"plan": 1.0, "quantity": 1.5},
+			wantDetails: []string{"plan", "quantity"},
+		},
+		{
+			name:          "unknown allowed",
+			properties:    map[string]interface{}{"plan": "pro", "source": "ads"},
+			unknownPolicy: shared.UnknownPropertyAllow,
+			want:          map[string]interface{}{"plan": "pro", "source": "ads"},
+		},
+		{
+			name:          "unknown stripped",
+			properties:    map[string]interface{}{"plan": "pro", "source": "ads"},
+			unknownPolicy: shared.UnknownPropertyStrip,
+			want:          map[string]interface{}{"plan": "pro"},
+		},
+		{
+			name:          "unknown rejected",
+			properties:    map[string]interface{}{"plan": "pro", "source": "ads"},
+			unknownPolicy: shared.UnknownPropertyReject,
+			wantDetails:   []string{"source"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateEventProperties(fields, tt.properties, tt.unknownPolicy)
+			if len(tt.wantDetails) > 0 {
+				var validationErr *errdefs.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("error = %v, want ValidationError", err)
+				}
+				if len(validationErr.Details) != len(tt.wantDetails) {
+					t.Errorf("details = %v, want keys %v", validationErr.Details, tt.wantDetails)
+				}
+				for _, key := range tt.wantDetails {
+					if _, ok := validationErr.Details[key]; !ok {
+						t.Errorf("details = %v, missing %s", validationErr.Details, key)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateEventProperties() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchDataType(t *testing.T) {
+	tests := []struct {
+		dataType string
+		value    interface{}
+		want     bool
+	}{
+		{"string", "pro", true},
+		{"string", 1.0, false},
+		{"int", float64(3), true},
+		{"int", 3.5, false},
+		{"int", int64(3), true},
+		{"int", "3", false},
+		{"float", 3.5, true},
+		{"float", 3, true},
+		{"float", "3.5", false},
+		{"boolean", true, true},
+		{"boolean", "true", false},
+		{"datetime", "2024-01-02 03:04:05", true},
+		{"datetime", "2024-01-02T03:04:05Z", true},
+		{"datetime", "2024-01-02", false},
+		{"datetime", 1704164645.0, false},
+		{"json", map[string]interface{}{"a": 1.0}, true},
+		{"json", []interface{}{1.0}, true},
+		{"json", "{}", false},
+		{"unknown", "anything", true},
+	}
+
+	for _, tt := range tests {
+		if got := matchDataType(tt.dataType, tt.value); got != tt.want {
+			t.Errorf("matchDataType(%q, %#v) = %v, want %v", tt.dataType, tt.value, got, tt.want)
+		}
+	}
+}
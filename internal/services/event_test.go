@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+func TestPartitionKey(t *testing.T) {
+	node, err := snowflake.NewNode(1)
+	if err != nil {
+		t.Fatalf("NewNode: %v", err)
+	}
+	userID := "user-1"
+
+	tests := []struct {
+		name     string
+		strategy string
+		eventLog *model.EventLog
+		want     string
+	}{
+		{
+			name:     "session",
+			strategy: shared.KafkaPartitionBySession,
+			eventLog: &model.EventLog{ApplicationID: "app-1", SessionID: "session-1", MessageID: "msg-1"},
+			want:     "session-1",
+		},
+		{
+			name:     "application",
+			strategy: shared.KafkaPartitionByApplication,
+			eventLog: &model.EventLog{ApplicationID: "app-1", SessionID: "session-1"},
+			want:     "app-1",
+		},
+		{
+			name:     "user",
+			strategy: shared.KafkaPartitionByUser,
+			eventLog: &model.EventLog{ApplicationID: "app-1", SessionID: "session-1", UserID: &userID},
+			want:     "app-1:user-1",
+		},
+		{
+			name:     "user without user id",
+			strategy: shared.KafkaPartitionByUser,
+			eventLog: &model.EventLog{ApplicationID: "app-1", SessionID: "session-1"},
+			want:     "session-1",
+		},
+		{
+			name:     "random with message id",
+			strategy: shared.KafkaPartitionRandom,
+			eventLog: &model.EventLog{ApplicationID: "app-1", SessionID: "session-1", MessageID: "msg-1"},
+			want:     "msg-1",
+		},
+		{
+			name:     "random without message id",
+			strategy: shared.KafkaPartitionRandom,
+			eventLog: &model.EventLog{ApplicationID: "app-1", SessionID: "session-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &EventService{config: &shared.Config{KafkaPartitionKey: tt.strategy}, snowflake: node}
+			got := s.partitionKey(tt.eventLog)
+			if tt.want == "" {
+				if got == "" || got == tt.eventLog.SessionID {
+					t.Errorf("partitionKey = %q, want a generated key", got)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("partitionKey = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+
+	"github.com/IBM/sarama"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+type OutboxStats struct {
+	Pending         int64
+	Failed          int64
+	Delivered       int64
+	OldestPendingAt *time.Time
+}
+
+type OutboxService struct {
+	snowflake *snowflake.Node
+	repo      repository.OutboxRepository
+}
+
+func NewOutboxService(
+	snowflake *snowflake.Node,
+	repo repository.OutboxRepository,
+) *OutboxService {
+	return &OutboxService{
+		snowflake: snowflake,
+		repo:      repo,
+	}
+}
+
+// Enqueue 將無法送達 Kafka 的訊息寫入 outbox，由 relay 於背景重送
+func (s *OutboxService) Enqueue(ctx context.Context, msgs []*sarama.ProducerMessage) error {
+	now := time.Now()
+	messages := make([]*model.OutboxMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		message, err := s.newOutboxMessage(msg, now)
+		if err != nil {
+			return errdefs.ErrorInternalError
+		}
+		messages = append(messages, message)
+	}
+
+	if err := s.repo.CreateOutboxMessages(ctx, messages); err != nil {
+		return errdefs.WrapGormError(err)
+	}
+	return nil
+}
+
+func (s *OutboxService) GetOutboxStats(ctx context.Context) (*OutboxStats, error) {
+	counts, err := s.repo.CountOutboxMessagesByStatus(ctx)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	stats := &OutboxStats{}
+	for _, count := range counts {
+		switch count.Status {
+		case model.OutboxStatusPending:
+			stats.Pending = count.Count
+		case model.OutboxStatusFailed:
+			stats.Failed = count.Count
+		case model.OutboxStatusDelivered:
+			stats.Delivered = count.Count
+		}
+	}
+
+	oldest, err := s.repo.GetOldestPendingOutboxMessage(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errdefs.WrapGormError(err)
+	}
+	if err == nil {
+		stats.OldestPendingAt = &oldest.CreatedAt
+	}
+
+	return stats, nil
+}
+
+func (s *OutboxService) RedriveOutbox(ctx context.Context) (int64, error) {
+	affected, err := s.repo.RedriveOutboxMessages(ctx)
+	if err != nil {
+		return 0, errdefs.WrapGormError(err)
+	}
+	return affected, nil
+}
+
+func (s *OutboxService) newOutboxMessage(msg *sarama.ProducerMessage, now time.Time) (*model.OutboxMessage, error) {
+	var key string
+	if msg.Key != nil {
+		b, err := msg.Key.Encode()
+		if err != nil {
+			return nil, err
+		}
+		key = string(b)
+	}
+
+	payload, err := msg.Value.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(model.OutboxHeaders, 0, len(msg.Headers))
+	for _, header := range msg.Headers {
+		headers = append(headers, model.OutboxHeader{Key: string(header.Key), Value: string(header.Value)})
+	}
+
+	return &model.OutboxMessage{
+		ID:            s.snowflake.Generate().String(),
+		Topic:         msg.Topic,
+		MessageKey:    key,
+		Payload:       payload,
+		Headers:       headers,
+		Status:        model.OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
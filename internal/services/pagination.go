@@ -0,0 +1,48 @@
+package service
+
+import (
+	"time"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	repository "tracking-service/internal/repositories"
+)
+
+const (
+	listDefaultLimit = 50
+)
+
+func toListFilter(in *datastructure.ListRequest) (*repository.ListFilter, error) {
+	filter := &repository.ListFilter{
+		Cursor:       in.Cursor,
+		Limit:        in.Limit,
+		Sort:         in.Sort,
+		NameContains: in.Name,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = listDefaultLimit
+	}
+	if in.CreatedFrom > 0 {
+		from := time.Unix(in.CreatedFrom, 0)
+		filter.CreatedFrom = &from
+	}
+	if in.CreatedTo > 0 {
+		to := time.Unix(in.CreatedTo, 0)
+		filter.CreatedTo = &to
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedTo.Before(*filter.CreatedFrom) {
+		return nil, errdefs.NewValidationError(map[string]string{
+			"created_to": "created_to must not be earlier than created_from",
+		})
+	}
+	return filter, nil
+}
+
+func toEventListFilter(in *datastructure.GetEventsRequest) (*repository.ListFilter, error) {
+	filter, err := toListFilter(&in.ListRequest)
+	if err != nil {
+		return nil, err
+	}
+	filter.PlatformID = in.PlatformID
+	filter.IsActive = in.IsActive
+	return filter, nil
+}
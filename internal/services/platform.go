@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strconv"
 	"time"
 	datastructure "tracking-service/internal/datastructures"
 	errdefs "tracking-service/internal/errors"
@@ -12,17 +13,20 @@ import (
 )
 
 type PlatformService struct {
-	snowflake *snowflake.Node
-	repo      repository.PlatformRepository
+	snowflake     *snowflake.Node
+	repo          repository.PlatformRepository
+	audit_service *AuditService
 }
 
 func NewPlatformService(
 	snowflake *snowflake.Node,
 	repo repository.PlatformRepository,
+	audit_service *AuditService,
 ) *PlatformService {
 	return &PlatformService{
-		snowflake: snowflake,
-		repo:      repo,
+		snowflake:     snowflake,
+		repo:          repo,
+		audit_service: audit_service,
 	}
 }
 
@@ -36,6 +40,13 @@ func (s *PlatformService) CreatePlatform(ctx context.Context, in *datastructure.
 		return nil, errdefs.WrapGormError(err)
 	}
 
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:       model.AuditActionCreate,
+		ResourceType: model.AuditResourcePlatform,
+		ResourceID:   strconv.Itoa(platform.ID),
+		After:        auditPlatform(platform),
+	})
+
 	return platform, nil
 }
 
@@ -47,10 +58,24 @@ func (s *PlatformService) GetPlatformByID(ctx context.Context, id int) (*model.P
 	return platform, nil
 }
 
-func (s *PlatformService) GetPlatforms(ctx context.Context) ([]*model.Platform, error) {
-	platforms, err := s.repo.GetPlatforms(ctx)
+// GetPlatforms 回傳下一頁的 cursor
+func (s *PlatformService) GetPlatforms(ctx context.Context, in *datastructure.ListRequest) ([]*model.Platform, string, error) {
+	filter, err := toListFilter(in)
 	if err != nil {
-		return nil, errdefs.WrapGormError(err)
+		return nil, "", err
+	}
+	// 平台 ID 為流水號
+	if filter.Cursor != "" {
+		if _, err := strconv.Atoi(filter.Cursor); err != nil {
+			return nil, "", errdefs.NewValidationError(map[string]string{
+				"cursor": "cursor is invalid",
+			})
+		}
+	}
+
+	platforms, nextCursor, err := s.repo.GetPlatforms(ctx, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
 	}
-	return platforms, nil
+	return platforms, nextCursor, nil
 }
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+	util "tracking-service/internal/utils"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+)
+
+const (
+	RateLimitScopeApplication  = "application"
+	RateLimitScopeTenant       = "tenant"
+	RateLimitScopeLoginIP      = "login_ip"
+	RateLimitScopeLoginAccount = "login_account"
+
+	// rateLimitBucketSize 為每個節點保留的令牌桶數量上限，超過時淘汰最久未使用的桶
+	rateLimitBucketSize = 100000
+	// tenantRateLimitCacheTTL 為租戶限制的快取時間，租戶設定異動最晚在此時間後生效
+	tenantRateLimitCacheTTL = 30 * time.Second
+	// 每個來源 IP 可連續嘗試登入 loginIPRateLimitBurst 次，同一來源對同一帳號為 loginAccountRateLimitBurst 次，之後每 loginRateLimitInterval 回復一次
+	loginIPRateLimitBurst      = 20
+	loginAccountRateLimitBurst = 5
+	loginRateLimitInterval     = 6 * time.Second
+)
+
+// RateLimitDecision Limit 為 0 代表未設定限制，ExceedsBurst 時沒有 RetryAfter
+type RateLimitDecision struct {
+	Allowed      bool
+	Scope        string
+	Limit        int
+	Remaining    int
+	Reset        time.Duration
+	RetryAfter   time.Duration
+	ExceedsBurst bool
+}
+
+// Err 回傳拒絕的原因，允許時為 nil
+func (d *RateLimitDecision) Err() error {
+	switch {
+	case d.Allowed:
+		return nil
+	case d.ExceedsBurst:
+		return errdefs.ErrorRateLimitExceedsBurst
+	default:
+		return errdefs.ErrorRateLimited
+	}
+}
+
+// RateLimitService 以各節點記憶體中的令牌桶限制應用程式與租戶的寫入速率，限制設定存於 Postgres
+type RateLimitService struct {
+	tenant_repo   repository.TenantRepository
+	buckets       *util.LRU[string, *rate.Limiter]
+	tenant_limits *util.LRU[string, model.RateLimit]
+	decisions     metric.Int64Counter
+}
+
+func NewRateLimitService(
+	tenant_repo repository.TenantRepository,
+) *RateLimitService {
+	decisions, err := otel.Meter("tracking-service").Int64Counter("rate_limit.decisions",
+		metric.WithDescription("Number of rate limit decisions on ingestion requests"))
+	if err != nil {
+		log.WithError(err).Error("failed to create rate limit decisions counter")
+	}
+
+	return &RateLimitService{
+		tenant_repo:   tenant_repo,
+		buckets:       util.NewLRU[string, *rate.Limiter](rateLimitBucketSize, 0),
+		tenant_limits: util.NewLRU[string, model.RateLimit](rateLimitBucketSize, tenantRateLimitCacheTTL),
+		decisions:     decisions,
+	}
+}
+
+// Allow 依序自應用程式與租戶的令牌桶取得 n 個令牌，n 為寫入的事件數量，任一層拒絕時歸還已取得的令牌，回傳剩餘額度最少的一層
+// n 超過桶容量時一律拒絕
+func (s *RateLimitService) Allow(ctx context.Context, application *model.Application, n int) (*RateLimitDecision, error) {
+	tenantLimit, err := s.tenantRateLimit(ctx, application.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	levels := make([]rateLimitLevel, 0, 2)
+	if application.RateLimit.Enabled() {
+		levels = append(levels, rateLimitLevel{
+			scope:   RateLimitScopeApplication,
+			limiter: s.limiter(RateLimitScopeApplication+":"+application.ID, application.RateLimit, now),
+		})
+	}
+	if tenantLimit.Enabled() {
+		levels = append(levels, rateLimitLevel{
+			scope:   RateLimitScopeTenant,
+			limiter: s.limiter(RateLimitScopeTenant+":"+application.TenantID, tenantLimit, now),
+		})
+	}
+	return s.reserve(ctx, levels, n, now), nil
+}
+
+// AllowLogin 依來源 IP 與來源 IP 加帳號的令牌桶限制後台登入嘗試，避免暴力猜測密碼
+// 帳號的桶依來源區分，他人無法以錯誤密碼鎖住其他來源的登入
+func (s *RateLimitService) AllowLogin(ctx context.Context, clientIP string, email string) *RateLimitDecision {
+	now := time.Now()
+	levels := make([]rateLimitLevel, 0, 2)
+	for _, level := range []struct {
+		scope string
+		key   string
+		burst int
+	}{
+		{RateLimitScopeLoginIP, clientIP, loginIPRateLimitBurst},
+		{RateLimitScopeLoginAccount, clientIP + "|" + strings.ToLower(email), loginAccountRateLimitBurst},
+	} {
+		limiter, _ := s.buckets.SetIfAbsent(level.scope+":"+level.key, rate.NewLimiter(rate.Every(loginRateLimitInterval), level.burst))
+		levels = append(levels, rateLimitLevel{scope: level.scope, limiter: limiter})
+	}
+	return s.reserve(ctx, levels, 1, now)
+}
+
+type rateLimitLevel struct {
+	scope   string
+	limiter *rate.Limiter
+}
+
+// reserve 依序自各層取得 n 個令牌，任一層拒絕時歸還已取得的令牌，回傳剩餘額度最少的一層
+func (s *RateLimitService) reserve(ctx context.Context, levels []rateLimitLevel, n int, now time.Time) *RateLimitDecision {
+	decision := &RateLimitDecision{Allowed: true}
+	reservations := make([]*rate.Reservation, 0, len(levels))
+	for _, level := range levels {
+		limiter := level.limiter
+		reservation := limiter.ReserveN(now, n)
+		delay := reservation.DelayFrom(now)
+		if !reservation.OK() || delay > 0 {
+			reservation.CancelAt(now)
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+
+			rejected := newRateLimitDecision(level.scope, limiter, now)
+			rejected.Allowed = false
+			// n 超過桶容量時 delay 為 rate.InfDuration，等待也無法取得
+			if reservation.OK() {
+				rejected.RetryAfter = delay
+			} else {
+				rejected.ExceedsBurst = true
+			}
+			s.record(ctx, rejected)
+			return rejected
+		}
+		reservations = append(reservations, reservation)
+
+		current := newRateLimitDecision(level.scope, limiter, now)
+		if decision.Limit == 0 || current.Remaining < decision.Remaining {
+			decision = current
+		}
+	}
+
+	if decision.Limit > 0 {
+		s.record(ctx, decision)
+	}
+	return decision
+}
+
+func (s *RateLimitService) limiter(key string, limit model.RateLimit, now time.Time) *rate.Limiter {
+	limiter, ok := s.buckets.Get(key)
+	if !ok {
+		limiter, _ = s.buckets.SetIfAbsent(key, rate.NewLimiter(rate.Limit(limit.PerSecond), limit.Capacity()))
+	}
+
+	// 設定異動後沿用既有的桶，僅調整速率與容量
+	if limiter.Limit() != rate.Limit(limit.PerSecond) {
+		limiter.SetLimitAt(now, rate.Limit(limit.PerSecond))
+	}
+	if limiter.Burst() != limit.Capacity() {
+		limiter.SetBurstAt(now, limit.Capacity())
+	}
+	return limiter
+}
+
+func (s *RateLimitService) tenantRateLimit(ctx context.Context, tenantID string) (model.RateLimit, error) {
+	if limit, ok := s.tenant_limits.Get(tenantID); ok {
+		return limit, nil
+	}
+
+	tenant, err := s.tenant_repo.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		return model.RateLimit{}, errdefs.WrapGormError(err)
+	}
+
+	s.tenant_limits.Set(tenantID, tenant.RateLimit)
+	return tenant.RateLimit, nil
+}
+
+func (s *RateLimitService) record(ctx context.Context, decision *RateLimitDecision) {
+	s.decisions.Add(ctx, 1, metric.WithAttributes(
+		attribute.Bool("allowed", decision.Allowed),
+		attribute.String("scope", decision.Scope),
+	))
+}
+
+func newRateLimitDecision(scope string, limiter *rate.Limiter, now time.Time) *RateLimitDecision {
+	tokens := max(limiter.TokensAt(now), 0)
+	burst := limiter.Burst()
+	return &RateLimitDecision{
+		Allowed:   true,
+		Scope:     scope,
+		Limit:     burst,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration((float64(burst) - tokens) / float64(limiter.Limit()) * float64(time.Second)),
+	}
+}
+
+// toRateLimit 將請求中的限制設定轉為 model，未帶入時回傳 nil 由呼叫端決定是否保留原設定
+func toRateLimit(in *datastructure.RateLimit) *model.RateLimit {
+	if in == nil {
+		return nil
+	}
+	return &model.RateLimit{
+		PerSecond: in.PerSecond,
+		Burst:     in.Burst,
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+)
+
+type fakeTenantRepository struct {
+	repository.TenantRepository
+	tenants map[string]*model.Tenant
+}
+
+func (r *fakeTenantRepository) GetTenantByID(_ context.Context, id string) (*model.Tenant, error) {
+	tenant, ok := r.tenants[id]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+	return tenant, nil
+}
+
+func newTestRateLimitService(tenantLimit model.RateLimit) *RateLimitService {
+	return NewRateLimitService(&fakeTenantRepository{tenants: map[string]*model.Tenant{
+		"tenant-1": {ID: "tenant-1", RateLimit: tenantLimit},
+	}})
+}
+
+func TestRateLimitServiceAllow(t *testing.T) {
+	tests := []struct {
+		name          string
+		appLimit      model.RateLimit
+		tenantLimit   model.RateLimit
+		n             []int
+		wantAllowed   bool
+		wantScope     string
+		wantLimit     int
+		wantRemaining int
+	}{
+		{name: "no limits", n: []int{100}, wantAllowed: true},
+		{name: "single event", appLimit: model.RateLimit{PerSecond: 10}, n: []int{1}, wantAllowed: true, wantScope: RateLimitScopeApplication, wantLimit: 10, wantRemaining: 9},
+		{name: "batch takes one token per event", appLimit: model.RateLimit{PerSecond: 10}, n: []int{3}, wantAllowed: true, wantScope: RateLimitScopeApplication, wantLimit: 10, wantRemaining: 7},
+		{name: "burst above rate", appLimit: model.RateLimit{PerSecond: 10, Burst: 20}, n: []int{20}, wantAllowed: true, wantScope: RateLimitScopeApplication, wantLimit: 20, wantRemaining: 0},
+		{name: "batch larger than burst", appLimit: model.RateLimit{PerSecond: 10}, n: []int{11}, wantAllowed: false, wantScope: RateLimitScopeApplication, wantLimit: 10, wantRemaining: 10},
+		{name: "bucket drained by earlier batch", appLimit: model.RateLimit{PerSecond: 10}, n: []int{6, 6}, wantAllowed: false, wantScope: RateLimitScopeApplication, wantLimit: 10, wantRemaining: 4},
+		{name: "tenant is the tighter level", appLimit: model.RateLimit{PerSecond: 10}, tenantLimit: model.RateLimit{PerSecond: 5}, n: []int{3}, wantAllowed: true, wantScope: RateLimitScopeTenant, wantLimit: 5, wantRemaining: 2},
+		{name: "application is the tighter level", appLimit: model.RateLimit{PerSecond: 5}, tenantLimit: model.RateLimit{PerSecond: 10}, n: []int{3}, wantAllowed: true, wantScope: RateLimitScopeApplication, wantLimit: 5, wantRemaining: 2},
+		{name: "rejected by tenant", appLimit: model.RateLimit{PerSecond: 10}, tenantLimit: model.RateLimit{PerSecond: 5}, n: []int{6}, wantAllowed: false, wantScope: RateLimitScopeTenant, wantLimit: 5, wantRemaining: 5},
+		{name: "tenant only", tenantLimit: model.RateLimit{PerSecond: 5}, n: []int{5}, wantAllowed: true, wantScope: RateLimitScopeTenant, wantLimit: 5, wantRemaining: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestRateLimitService(tt.tenantLimit)
+			application := &model.Application{ID: "app-1", TenantID: "tenant-1", RateLimit: tt.appLimit}
+
+			var decision *RateLimitDecision
+			for _, n := range tt.n {
+				var err error
+				decision, err = s.Allow(context.Background(), application, n)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if decision.Allowed != tt.wantAllowed || decision.Scope != tt.wantScope || decision.Limit != tt.wantLimit || decision.Remaining != tt.wantRemaining {
+				t.Errorf("Allow() = {Allowed: %v, Scope: %q, Limit: %d, Remaining: %d}, want {Allowed: %v, Scope: %q, Limit: %d, Remaining: %d}",
+					decision.Allowed, decision.Scope, decision.Limit, decision.Remaining,
+					tt.wantAllowed, tt.wantScope, tt.wantLimit, tt.wantRemaining)
+			}
+			if !decision.Allowed && !decision.ExceedsBurst && decision.RetryAfter <= 0 {
+				t.Errorf("RetryAfter = %v, want > 0", decision.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestRateLimitServiceAllowExceedsBurst(t *testing.T) {
+	s := newTestRateLimitService(model.RateLimit{})
+	application := &model.Application{ID: "app-1", TenantID: "tenant-1", RateLimit: model.RateLimit{PerSecond: 10}}
+
+	decision, err := s.Allow(context.Background(), application, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decision.Allowed || !decision.ExceedsBurst {
+		t.Fatalf("Allow(11) = {Allowed: %v, ExceedsBurst: %v}, want {Allowed: false, ExceedsBurst: true}", decision.Allowed, decision.ExceedsBurst)
+	}
+	// 超過桶容量時等待也無法通過，不回傳重試時間
+	if decision.RetryAfter != 0 {
+		t.Errorf("RetryAfter = %v, want 0", decision.RetryAfter)
+	}
+	if err := decision.Err(); !errors.Is(err, errdefs.ErrorRateLimitExceedsBurst) || !errors.Is(err, errdefs.ErrorRateLimited) {
+		t.Errorf("Err() = %v, want ErrorRateLimitExceedsBurst", err)
+	}
+}
+
+func TestRateLimitServiceAllowRefundsOnRejection(t *testing.T) {
+	s := newTestRateLimitService(model.RateLimit{PerSecond: 5})
+	application := &model.Application{ID: "app-1", TenantID: "tenant-1", RateLimit: model.RateLimit{PerSecond: 10}}
+	ctx := context.Background()
+
+	// 租戶拒絕時歸還應用程式層已取得的令牌
+	if decision, _ := s.Allow(ctx, application, 6); decision.Allowed {
+		t.Fatal("Allow(6) allowed, want rejected by tenant")
+	}
+	decision, err := s.Allow(ctx, application, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decision.Allowed {
+		t.Fatal("Allow(5) rejected, want allowed")
+	}
+
+	limiter, _ := s.buckets.Get(RateLimitScopeApplication + ":app-1")
+	if remaining := int(limiter.Tokens()); remaining != 5 {
+		t.Errorf("application remaining = %d, want 5", remaining)
+	}
+}
+
+func TestRateLimitServiceAllowTenantError(t *testing.T) {
+	s := newTestRateLimitService(model.RateLimit{})
+	application := &model.Application{ID: "app-1", TenantID: "missing", RateLimit: model.RateLimit{PerSecond: 10}}
+
+	if _, err := s.Allow(context.Background(), application, 1); err == nil {
+		t.Error("Allow() error = nil, want error for missing tenant")
+	}
+}
+
+func TestRateLimitServiceAllowLogin(t *testing.T) {
+	tests := []struct {
+		name        string
+		attempts    [][2]string
+		ip          string
+		email       string
+		wantAllowed bool
+		wantScope   string
+	}{
+		{name: "first attempt", ip: "10.0.0.1", email: "admin@example.com", wantAllowed: true},
+		{name: "same ip across accounts", attempts: repeatAttempts(loginIPRateLimitBurst, "10.0.0.1", ""), ip: "10.0.0.1", email: "other@example.com", wantAllowed: false, wantScope: RateLimitScopeLoginIP},
+		{name: "same account from one ip", attempts: repeatAttempts(loginAccountRateLimitBurst, "10.0.0.1", "admin@example.com"), ip: "10.0.0.1", email: "admin@example.com", wantAllowed: false, wantScope: RateLimitScopeLoginAccount},
+		{name: "account is case insensitive", attempts: repeatAttempts(loginAccountRateLimitBurst, "10.0.0.1", "Admin@Example.com"), ip: "10.0.0.1", email: "admin@example.com", wantAllowed: false, wantScope: RateLimitScopeLoginAccount},
+		{name: "account not locked out for other ips", attempts: repeatAttempts(loginIPRateLimitBurst, "", "admin@example.com"), ip: "10.0.0.99", email: "admin@example.com", wantAllowed: true},
+		{name: "below burst", attempts: repeatAttempts(loginAccountRateLimitBurst-1, "10.0.0.1", "admin@example.com"), ip: "10.0.0.1", email: "admin@example.com", wantAllowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestRateLimitService(model.RateLimit{})
+			ctx := context.Background()
+			for _, attempt := range tt.attempts {
+				s.AllowLogin(ctx, attempt[0], attempt[1])
+			}
+
+			decision := s.AllowLogin(ctx, tt.ip, tt.email)
+			if decision.Allowed != tt.wantAllowed {
+				t.Fatalf("AllowLogin() allowed = %v, want %v", decision.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && decision.Scope != tt.wantScope {
+				t.Errorf("AllowLogin() scope = %q, want %q", decision.Scope, tt.wantScope)
+			}
+		})
+	}
+}
+
+// repeatAttempts 未指定的 IP 或帳號以序號區分，僅累計指定的一側
+func repeatAttempts(n int, ip string, email string) [][2]string {
+	attempts := make([][2]string, n)
+	for i := range attempts {
+		attempts[i] = [2]string{ip, email}
+		if ip == "" {
+			attempts[i][0] = "192.168.0." + string(rune('a'+i))
+		}
+		if email == "" {
+			attempts[i][1] = string(rune('a'+i)) + "@example.com"
+		}
+	}
+	return attempts
+}
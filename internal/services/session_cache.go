@@ -0,0 +1,53 @@
+package service
+
+import (
+	"time"
+	model "tracking-service/internal/models"
+	util "tracking-service/internal/utils"
+)
+
+const (
+	sessionUserCacheSize = 10000
+	// session 可於登入後補上 user_id，快取時間不宜過長
+	sessionUserCacheTTL = time.Minute
+	sessionKeyCacheSize = 10000
+	// session_key 對應的 session ID 不會改變，結束的 session 另由 sessions 判斷
+	sessionKeyCacheTTL = 10 * time.Minute
+)
+
+// SessionCache 快取寫入事件日誌時參照的 session 與 session_key 對應的 session ID
+// session 於本節點結束、更新或刪除時立即清除，其他節點最多延遲 sessionUserCacheTTL 生效
+type SessionCache struct {
+	sessions *util.LRU[string, *model.Session]
+	keys     *util.LRU[string, string]
+}
+
+func NewSessionCache() *SessionCache {
+	return &SessionCache{
+		sessions: util.NewLRU[string, *model.Session](sessionUserCacheSize, sessionUserCacheTTL),
+		keys:     util.NewLRU[string, string](sessionKeyCacheSize, sessionKeyCacheTTL),
+	}
+}
+
+func (c *SessionCache) get(applicationID string, sessionID string) (*model.Session, bool) {
+	return c.sessions.Get(applicationID + ":" + sessionID)
+}
+
+func (c *SessionCache) getKey(applicationID string, sessionKey string) (string, bool) {
+	return c.keys.Get(applicationID + ":" + sessionKey)
+}
+
+// set 快取取出的 session 會被多個請求共用，不可修改
+func (c *SessionCache) set(session *model.Session) {
+	c.sessions.Set(session.ApplicationID+":"+session.ID, session)
+	if session.SessionKey != "" {
+		c.keys.Set(session.ApplicationID+":"+session.SessionKey, session.ID)
+	}
+}
+
+func (c *SessionCache) evict(session *model.Session) {
+	c.sessions.Delete(session.ApplicationID + ":" + session.ID)
+	if session.SessionKey != "" {
+		c.keys.Delete(session.ApplicationID + ":" + session.SessionKey)
+	}
+}
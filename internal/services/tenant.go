@@ -12,17 +12,20 @@ import (
 )
 
 type TenantService struct {
-	snowflake *snowflake.Node
-	repo      repository.TenantRepository
+	snowflake     *snowflake.Node
+	repo          repository.TenantRepository
+	audit_service *AuditService
 }
 
 func NewTenantService(
 	snowflake *snowflake.Node,
 	repo repository.TenantRepository,
+	audit_service *AuditService,
 ) *TenantService {
 	return &TenantService{
-		snowflake: snowflake,
-		repo:      repo,
+		snowflake:     snowflake,
+		repo:          repo,
+		audit_service: audit_service,
 	}
 }
 
@@ -33,11 +36,25 @@ func (s *TenantService) CreateTenant(ctx context.Context, in *datastructure.Tena
 		Description: in.Description,
 		CreatedAt:   time.Now(),
 	}
+	if rateLimit := toRateLimit(in.RateLimit); rateLimit != nil {
+		tenant.RateLimit = *rateLimit
+	}
+	if quota := toQuota(in.Quota); quota != nil {
+		tenant.Quota = *quota
+	}
 
 	if err := s.repo.CreateTenant(ctx, tenant); err != nil {
 		return nil, errdefs.WrapGormError(err)
 	}
 
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:       model.AuditActionCreate,
+		ResourceType: model.AuditResourceTenant,
+		ResourceID:   tenant.ID,
+		TenantID:     tenant.ID,
+		After:        auditTenant(tenant),
+	})
+
 	return tenant, nil
 }
 
@@ -54,14 +71,44 @@ func (s *TenantService) UpdateTenant(ctx context.Context, id string, in *datastr
 	if err != nil {
 		return errdefs.WrapGormError(err)
 	}
+	before := auditTenant(tenant)
 
 	tenant.Name = in.Name
 	tenant.Description = in.Description
+	if rateLimit := toRateLimit(in.RateLimit); rateLimit != nil {
+		tenant.RateLimit = *rateLimit
+	}
+	if quota := toQuota(in.Quota); quota != nil {
+		tenant.Quota = *quota
+	}
 	tenant.UpdatedAt = time.Now()
 
-	return s.repo.UpdateTenant(ctx, tenant)
+	if err := s.repo.UpdateTenant(ctx, tenant); err != nil {
+		return err
+	}
+
+	s.audit_service.Record(ctx, &AuditEntry{
+		Action:       model.AuditActionUpdate,
+		ResourceType: model.AuditResourceTenant,
+		ResourceID:   tenant.ID,
+		TenantID:     tenant.ID,
+		Before:       before,
+		After:        auditTenant(tenant),
+	})
+	return nil
 }
 
-func (s *TenantService) GetTenants(ctx context.Context) ([]*model.Tenant, error) {
-	return s.repo.GetTenants(ctx)
+// GetTenants tenantID 非空字串時僅回傳該租戶，回傳下一頁的 cursor
+func (s *TenantService) GetTenants(ctx context.Context, tenantID string, in *datastructure.ListRequest) ([]*model.Tenant, string, error) {
+	filter, err := toListFilter(in)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.TenantID = tenantID
+
+	tenants, nextCursor, err := s.repo.GetTenants(ctx, filter)
+	if err != nil {
+		return nil, "", errdefs.WrapGormError(err)
+	}
+	return tenants, nextCursor, nil
 }
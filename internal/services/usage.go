@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+	shared "tracking-service/internal"
+	datastructure "tracking-service/internal/datastructures"
+	errdefs "tracking-service/internal/errors"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+	util "tracking-service/internal/utils"
+)
+
+const (
+	usageDateFormat = "2006-01-02"
+	// usageMaxRangeDays 為單次查詢用量的最大天數
+	usageMaxRangeDays = 366
+	// monthlyUsageCacheSize 為每個節點快取的租戶當月用量數量上限
+	monthlyUsageCacheSize = 10000
+	// monthlyUsageCacheTTL 為租戶當月用量與配額的快取時間，其他節點的用量最晚在此時間後反映
+	monthlyUsageCacheTTL = 30 * time.Second
+)
+
+// QuotaDecision Limit 為 0 代表未設定配額
+type QuotaDecision struct {
+	Allowed bool
+	Metric  string
+	Limit   int64
+	Used    int64
+	// Warning 代表用量已達軟性上限
+	Warning bool
+}
+
+type UsageReport struct {
+	From   time.Time
+	To     time.Time
+	Usages []*model.UsageDaily
+	Quotas []*QuotaDecision
+}
+
+type usageKey struct {
+	tenantID      string
+	applicationID string
+	metric        string
+	date          time.Time
+}
+
+type usageCount struct {
+	accepted int64
+	rejected int64
+}
+
+// monthlyUsage 為已寫入資料庫的租戶當月接受數量
+type monthlyUsage struct {
+	month time.Time
+	quota model.Quota
+	used  map[string]int64
+}
+
+// UsageService 於記憶體中彙總各應用程式每日的用量，由 UsageFlusher 定期累加寫入 Postgres，並依租戶每月配額限制寫入
+type UsageService struct {
+	config        *shared.Config
+	repo          repository.UsageRepository
+	tenant_repo   repository.TenantRepository
+	monthly_usage *util.LRU[string, *monthlyUsage]
+
+	// flush_mu 讓 Flush 依序執行
+	flush_mu sync.Mutex
+
+	mu      sync.Mutex
+	pending map[usageKey]*usageCount
+	// flushing 為寫入資料庫中的用量，寫入完成且快取清除前仍計入配額
+	flushing map[usageKey]*usageCount
+	// generation 於每次寫入完成後遞增，寫入前查詢的當月用量不寫入快取
+	generation uint64
+}
+
+func NewUsageService(
+	config *shared.Config,
+	repo repository.UsageRepository,
+	tenant_repo repository.TenantRepository,
+) *UsageService {
+	return &UsageService{
+		config:        config,
+		repo:          repo,
+		tenant_repo:   tenant_repo,
+		monthly_usage: util.NewLRU[string, *monthlyUsage](monthlyUsageCacheSize, monthlyUsageCacheTTL),
+		pending:       make(map[usageKey]*usageCount),
+	}
+}
+
+// Record 累加應用程式當日的用量，寫入資料庫前僅存在於本節點記憶體
+func (s *UsageService) Record(tenantID string, applicationID string, metric string, accepted int, rejected int) {
+	if accepted == 0 && rejected == 0 {
+		return
+	}
+
+	key := usageKey{
+		tenantID:      tenantID,
+		applicationID: applicationID,
+		metric:        metric,
+		date:          usageDate(time.Now()),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(key, &usageCount{accepted: int64(accepted), rejected: int64(rejected)})
+}
+
+// Flush 將記憶體中的用量累加寫入資料庫，失敗時保留於記憶體待下次寫入
+func (s *UsageService) Flush(ctx context.Context) error {
+	s.flush_mu.Lock()
+	defer s.flush_mu.Unlock()
+
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[usageKey]*usageCount)
+	s.flushing = pending
+	s.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	usages := make([]*model.UsageDaily, 0, len(pending))
+	for key, count := range pending {
+		usages = append(usages, &model.UsageDaily{
+			ApplicationID: key.applicationID,
+			UsageDate:     key.date,
+			Metric:        key.metric,
+			TenantID:      key.tenantID,
+			Accepted:      count.accepted,
+			Rejected:      count.rejected,
+			UpdatedAt:     now,
+		})
+	}
+
+	if err := s.repo.IncrementUsageDaily(ctx, usages); err != nil {
+		s.mu.Lock()
+		s.flushing = nil
+		for key, count := range pending {
+			s.add(key, count)
+		}
+		s.mu.Unlock()
+		return errdefs.WrapGormError(err)
+	}
+
+	// 已寫入的用量改由資料庫計算，清除快取讓下次檢查配額時重新查詢
+	s.mu.Lock()
+	s.monthly_usage.Purge()
+	s.flushing = nil
+	s.generation++
+	s.mu.Unlock()
+	return nil
+}
+
+// CheckQuota 以已寫入資料庫與本節點尚未寫入的當月接受數量判斷再寫入 n 筆是否超過租戶配額
+func (s *UsageService) CheckQuota(ctx context.Context, tenantID string, metric string, n int) (*QuotaDecision, error) {
+	now := time.Now()
+	usage, err := s.getMonthlyUsage(ctx, tenantID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quotaDecision(tenantID, metric, usage, n), nil
+}
+
+// GetUsage 用量最多延遲一個寫入間隔，Quotas 為當月用量與配額
+func (s *UsageService) GetUsage(ctx context.Context, tenantID string, in *datastructure.GetUsageRequest) (*UsageReport, error) {
+	now := time.Now()
+	from := usageMonth(now)
+	to := usageDate(now)
+
+	if in.From != "" {
+		t, err := util.ParseTime(in.From, usageDateFormat)
+		if err != nil {
+			return nil, errdefs.ErrorInvalidRequest
+		}
+		from = t
+	}
+	if in.To != "" {
+		t, err := util.ParseTime(in.To, usageDateFormat)
+		if err != nil {
+			return nil, errdefs.ErrorInvalidRequest
+		}
+		to = t
+	}
+	if to.Before(from) {
+		return nil, errdefs.NewValidationError(map[string]string{
+			"to": "to must not be earlier than from",
+		})
+	}
+	if to.Sub(from) >= usageMaxRangeDays*24*time.Hour {
+		return nil, errdefs.NewValidationError(map[string]string{
+			"from": "date range must be at most 366 days",
+		})
+	}
+
+	usage, err := s.getMonthlyUsage(ctx, tenantID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := s.repo.GetUsageDaily(ctx, tenantID, in.ApplicationID, from, to)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	report := &UsageReport{
+		From:   from,
+		To:     to,
+		Usages: usages,
+		Quotas: make([]*QuotaDecision, 0, len(model.UsageMetrics)),
+	}
+	for _, metric := range model.UsageMetrics {
+		report.Quotas = append(report.Quotas, s.quotaDecision(tenantID, metric, usage, 1))
+	}
+	return report, nil
+}
+
+// quotaDecision Allowed 代表再寫入 n 筆後仍未超過配額
+func (s *UsageService) quotaDecision(tenantID string, metric string, usage *monthlyUsage, n int) *QuotaDecision {
+	decision := &QuotaDecision{
+		Allowed: true,
+		Metric:  metric,
+		Limit:   usage.quota.Limit(metric),
+		Used:    usage.used[metric] + s.pendingAccepted(tenantID, metric, usage.month),
+	}
+	if decision.Limit <= 0 {
+		return decision
+	}
+
+	decision.Allowed = decision.Used+int64(n) <= decision.Limit
+	decision.Warning = s.config.QuotaSoftLimitRatio > 0 &&
+		float64(decision.Used) >= float64(decision.Limit)*s.config.QuotaSoftLimitRatio
+	return decision
+}
+
+func (s *UsageService) getMonthlyUsage(ctx context.Context, tenantID string, now time.Time) (*monthlyUsage, error) {
+	month := usageMonth(now)
+	if usage, ok := s.monthly_usage.Get(tenantID); ok && usage.month.Equal(month) {
+		return usage, nil
+	}
+
+	s.mu.Lock()
+	generation := s.generation
+	s.mu.Unlock()
+
+	tenant, err := s.tenant_repo.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+
+	usage := &monthlyUsage{
+		month: month,
+		quota: tenant.Quota,
+		used:  make(map[string]int64, len(model.UsageMetrics)),
+	}
+	totals, err := s.repo.SumUsageByTenant(ctx, tenantID, month, usageDate(now))
+	if err != nil {
+		return nil, errdefs.WrapGormError(err)
+	}
+	for _, total := range totals {
+		usage.used[total.Metric] = total.Accepted
+	}
+
+	// 查詢期間有用量寫入完成時結果可能不含該批用量，僅供本次使用
+	s.mu.Lock()
+	if s.generation == generation {
+		s.monthly_usage.Set(tenantID, usage)
+	}
+	s.mu.Unlock()
+	return usage, nil
+}
+
+func (s *UsageService) pendingAccepted(tenantID string, metric string, month time.Time) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var accepted int64
+	for _, counts := range []map[usageKey]*usageCount{s.pending, s.flushing} {
+		for key, count := range counts {
+			if key.tenantID == tenantID && key.metric == metric && !key.date.Before(month) {
+				accepted += count.accepted
+			}
+		}
+	}
+	return accepted
+}
+
+// add 呼叫端需持有 mu
+func (s *UsageService) add(key usageKey, count *usageCount) {
+	if existing, ok := s.pending[key]; ok {
+		existing.accepted += count.accepted
+		existing.rejected += count.rejected
+		return
+	}
+	s.pending[key] = &usageCount{accepted: count.accepted, rejected: count.rejected}
+}
+
+func usageDate(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+func usageMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// toQuota 將請求中的配額轉為 model，未帶入時回傳 nil 由呼叫端決定是否保留原設定
+func toQuota(in *datastructure.Quota) *model.Quota {
+	if in == nil {
+		return nil
+	}
+	return &model.Quota{
+		MonthlyEventLogs: in.MonthlyEventLogs,
+		MonthlySessions:  in.MonthlySessions,
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+)
+
+type fakeUsageRepository struct {
+	repository.UsageRepository
+	totals       []*repository.UsageTotal
+	incrementErr error
+	incremented  []*model.UsageDaily
+	// onIncrement 於寫入提交前呼叫
+	onIncrement func()
+}
+
+func (r *fakeUsageRepository) SumUsageByTenant(context.Context, string, time.Time, time.Time) ([]*repository.UsageTotal, error) {
+	return r.totals, nil
+}
+
+func (r *fakeUsageRepository) IncrementUsageDaily(_ context.Context, usages []*model.UsageDaily) error {
+	if r.onIncrement != nil {
+		r.onIncrement()
+	}
+	if r.incrementErr != nil {
+		return r.incrementErr
+	}
+	r.incremented = append(r.incremented, usages...)
+	for _, usage := range usages {
+		for _, total := range r.totals {
+			if total.Metric == usage.Metric {
+				total.Accepted += usage.Accepted
+			}
+		}
+	}
+	return nil
+}
+
+func newTestUsageService(quota model.Quota, totals []*repository.UsageTotal, softLimitRatio float64) (*UsageService, *fakeUsageRepository) {
+	repo := &fakeUsageRepository{totals: totals}
+	tenants := &fakeTenantRepository{tenants: map[string]*model.Tenant{
+		"tenant-1": {ID: "tenant-1", Quota: quota},
+	}}
+	return NewUsageService(&shared.Config{QuotaSoftLimitRatio: softLimitRatio}, repo, tenants), repo
+}
+
+func TestUsageServiceCheckQuota(t *testing.T) {
+	tests := []struct {
+		name            string
+		quota           model.Quota
+		stored          int64
+		pendingAccepted int
+		pendingRejected int
+		softLimitRatio  float64
+		n               int
+		wantAllowed     bool
+		wantUsed        int64
+		wantWarning     bool
+	}{
+		{name: "no quota", stored: 1000, n: 100, wantAllowed: true, wantUsed: 1000},
+		{name: "below quota", quota: model.Quota{MonthlyEventLogs: 100}, stored: 10, n: 1, wantAllowed: true, wantUsed: 10},
+		{name: "batch fills quota exactly", quota: model.Quota{MonthlyEventLogs: 100}, stored: 90, n: 10, wantAllowed: true, wantUsed: 90},
+		{name: "batch exceeds quota", quota: model.Quota{MonthlyEventLogs: 100}, stored: 90, n: 11, wantAllowed: false, wantUsed: 90},
+		{name: "quota already reached", quota: model.Quota{MonthlyEventLogs: 100}, stored: 100, n: 1, wantAllowed: false, wantUsed: 100},
+		{name: "pending accepted counted", quota: model.Quota{MonthlyEventLogs: 100}, stored: 90, pendingAccepted: 8, n: 3, wantAllowed: false, wantUsed: 98},
+		{name: "pending rejected not counted", quota: model.Quota{MonthlyEventLogs: 100}, stored: 90, pendingRejected: 50, n: 10, wantAllowed: true, wantUsed: 90},
+		{name: "soft limit reached", quota: model.Quota{MonthlyEventLogs: 100}, stored: 80, softLimitRatio: 0.8, n: 1, wantAllowed: true, wantUsed: 80, wantWarning: true},
+		{name: "below soft limit", quota: model.Quota{MonthlyEventLogs: 100}, stored: 79, softLimitRatio: 0.8, n: 1, wantAllowed: true, wantUsed: 79},
+		{name: "soft limit disabled", quota: model.Quota{MonthlyEventLogs: 100}, stored: 99, n: 1, wantAllowed: true, wantUsed: 99},
+		{name: "other metric quota ignored", quota: model.Quota{MonthlySessions: 1}, stored: 1000, n: 1, wantAllowed: true, wantUsed: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestUsageService(tt.quota, []*repository.UsageTotal{
+				{Metric: model.UsageMetricEventLogs, Accepted: tt.stored},
+			}, tt.softLimitRatio)
+			s.Record("tenant-1", "app-1", model.UsageMetricEventLogs, tt.pendingAccepted, tt.pendingRejected)
+
+			decision, err := s.CheckQuota(context.Background(), "tenant-1", model.UsageMetricEventLogs, tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decision.Allowed != tt.wantAllowed || decision.Used != tt.wantUsed || decision.Warning != tt.wantWarning {
+				t.Errorf("CheckQuota() = {Allowed: %v, Used: %d, Warning: %v}, want {Allowed: %v, Used: %d, Warning: %v}",
+					decision.Allowed, decision.Used, decision.Warning, tt.wantAllowed, tt.wantUsed, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestUsageServicePendingAcceptedCurrentMonthOnly(t *testing.T) {
+	s, _ := newTestUsageService(model.Quota{MonthlyEventLogs: 100}, nil, 0)
+	month := usageMonth(time.Now())
+	s.pending[usageKey{tenantID: "tenant-1", applicationID: "app-1", metric: model.UsageMetricEventLogs, date: month.AddDate(0, 0, -1)}] = &usageCount{accepted: 50}
+	s.pending[usageKey{tenantID: "tenant-1", applicationID: "app-2", metric: model.UsageMetricEventLogs, date: month}] = &usageCount{accepted: 7}
+	s.pending[usageKey{tenantID: "tenant-2", applicationID: "app-3", metric: model.UsageMetricEventLogs, date: month}] = &usageCount{accepted: 20}
+
+	if got := s.pendingAccepted("tenant-1", model.UsageMetricEventLogs, month); got != 7 {
+		t.Errorf("pendingAccepted() = %d, want 7", got)
+	}
+}
+
+func TestUsageServiceFlush(t *testing.T) {
+	tests := []struct {
+		name         string
+		incrementErr error
+		wantErr      bool
+		wantPending  int64
+		wantFlushed  int
+	}{
+		{name: "written", wantFlushed: 1},
+		{name: "failed write kept in memory", incrementErr: errors.New("connection refused"), wantErr: true, wantPending: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := newTestUsageService(model.Quota{}, nil, 0)
+			repo.incrementErr = tt.incrementErr
+			s.Record("tenant-1", "app-1", model.UsageMetricEventLogs, 5, 1)
+
+			err := s.Flush(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Flush() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(repo.incremented) != tt.wantFlushed {
+				t.Errorf("flushed %d usages, want %d", len(repo.incremented), tt.wantFlushed)
+			}
+			if got := s.pendingAccepted("tenant-1", model.UsageMetricEventLogs, usageMonth(time.Now())); got != tt.wantPending {
+				t.Errorf("pending accepted = %d, want %d", got, tt.wantPending)
+			}
+		})
+	}
+}
+
+func TestUsageServiceCheckQuotaDuringFlush(t *testing.T) {
+	tests := []struct {
+		name         string
+		incrementErr error
+		wantUsed     int64
+	}{
+		{name: "written", wantUsed: 98},
+		{name: "failed write", incrementErr: errors.New("connection refused"), wantUsed: 98},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, repo := newTestUsageService(model.Quota{MonthlyEventLogs: 100}, []*repository.UsageTotal{
+				{Metric: model.UsageMetricEventLogs, Accepted: 90},
+			}, 0)
+			ctx := context.Background()
+			if _, err := s.CheckQuota(ctx, "tenant-1", model.UsageMetricEventLogs, 1); err != nil {
+				t.Fatal(err)
+			}
+			s.Record("tenant-1", "app-1", model.UsageMetricEventLogs, 8, 0)
+
+			// 寫入提交前的配額檢查仍須計入寫入中的用量
+			repo.incrementErr = tt.incrementErr
+			repo.onIncrement = func() {
+				decision, err := s.CheckQuota(ctx, "tenant-1", model.UsageMetricEventLogs, 3)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if decision.Allowed || decision.Used != 98 {
+					t.Errorf("CheckQuota() during flush = {Allowed: %v, Used: %d}, want {Allowed: false, Used: 98}", decision.Allowed, decision.Used)
+				}
+			}
+			_ = s.Flush(ctx)
+			repo.onIncrement = nil
+
+			decision, err := s.CheckQuota(ctx, "tenant-1", model.UsageMetricEventLogs, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decision.Allowed || decision.Used != tt.wantUsed {
+				t.Errorf("CheckQuota() after flush = {Allowed: %v, Used: %d}, want {Allowed: false, Used: %d}", decision.Allowed, decision.Used, tt.wantUsed)
+			}
+		})
+	}
+}
@@ -1,5 +1,12 @@
 package shared
 
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
 const (
 	LOG_FORMAT_JSON = "json"
 	LOG_FORMAT_TEXT = "text"
@@ -10,12 +17,91 @@ const (
 	KafkaGroupId = "tracking_group"
 )
 
+// 事件屬性未定義於 EventField 時的處理策略
+const (
+	UnknownPropertyAllow  = "allow"
+	UnknownPropertyStrip  = "strip"
+	UnknownPropertyReject = "reject"
+)
+
+// 冪等鍵的儲存方式，memory 適用單節點，postgres 供多節點共用
+const (
+	IdempotencyStoreMemory   = "memory"
+	IdempotencyStorePostgres = "postgres"
+)
+
+// Kafka 訊息的分區 key 策略
+const (
+	KafkaPartitionBySession     = "session_id"
+	KafkaPartitionByApplication = "application_id"
+	KafkaPartitionByUser        = "user_id"
+	KafkaPartitionRandom        = "random"
+)
+
+// Kafka 訊息標頭，讓 consumer 不需解析內容即可路由與過濾
+const (
+	KafkaHeaderMessageID     = "message_id"
+	KafkaHeaderTenantID      = "tenant_id"
+	KafkaHeaderApplicationID = "application_id"
+	KafkaHeaderEventID       = "event_id"
+	KafkaHeaderSchemaVersion = "schema_version"
+)
+
+// EventLogSchemaVersion 為 Kafka 中事件日誌內容的格式版本，格式異動時需遞增
+const EventLogSchemaVersion = "2"
+
+// 應用程式 API 密鑰的權限範圍
+const (
+	APIKeyScopeIngest      = "ingest"
+	APIKeyScopeSchemaRead  = "schema:read"
+	APIKeyScopeSchemaWrite = "schema:write"
+	APIKeyScopeSessions    = "sessions"
+)
+
+// APIKeyScopes 為建立密鑰未指定權限範圍時的預設值，與既有密鑰相同擁有全部權限
+var APIKeyScopes = []string{
+	APIKeyScopeIngest,
+	APIKeyScopeSchemaRead,
+	APIKeyScopeSchemaWrite,
+	APIKeyScopeSessions,
+}
+
+// 應用程式 API 密鑰類型，publishable 密鑰可嵌入瀏覽器，僅能用於事件寫入與工作階段且須通過來源檢查
+const (
+	APIKeyTypeSecret      = "secret"
+	APIKeyTypePublishable = "publishable"
+)
+
+// PublishableAPIKeyScopes 為 publishable 密鑰可擁有的權限範圍
+var PublishableAPIKeyScopes = []string{
+	APIKeyScopeIngest,
+	APIKeyScopeSessions,
+}
+
+// APIKeyInvalidationChannel 為通知各節點清除 API 密鑰快取的 Postgres NOTIFY channel
+const APIKeyInvalidationChannel = "tracking_api_key_invalidation"
+
+// 後台使用者角色，tenant_admin 僅能管理所屬租戶，read_only 設定租戶時僅能檢視該租戶
+const (
+	AdminRoleSuperAdmin  = "super_admin"
+	AdminRoleTenantAdmin = "tenant_admin"
+	AdminRoleReadOnly    = "read_only"
+	// AdminRoleBootstrap 為以 ADMIN_API_KEY 驗證的身分，僅能管理後台使用者
+	AdminRoleBootstrap = "bootstrap"
+)
+
 type contextKey string
 
 const (
 	AdminApiKey            contextKey = "admin_key"
+	AdminPrincipalKey      contextKey = "admin_principal"
+	AuditActorKey          contextKey = "audit_actor"
 	TenantApplicationIDKey contextKey = "tenant_application_id"
 	TenantIDKey            contextKey = "tenant_id"
+	TenantAPIKeyScopesKey  contextKey = "tenant_api_key_scopes"
+	TenantAPIKeyTypeKey    contextKey = "tenant_api_key_type"
+	TenantApplicationKey   contextKey = "tenant_application"
+	EventLogBatchSizeKey   contextKey = "event_log_batch_size"
 )
 
 type Config struct {
@@ -38,4 +124,84 @@ type Config struct {
 	KafkaBrokers     string
 	KafkaVersion     string
 	AdminApiKey      string
+
+	KafkaPartitionKey string
+
+	AdminJWTSecret string
+	AdminJWTTTL    time.Duration
+
+	// TrustedProxies 為以逗號分隔、可信任其 X-Forwarded-For 的代理 IP 或 CIDR，空值時以連線來源 IP 為準
+	TrustedProxies string
+
+	ApiKeyDefaultTTL          time.Duration
+	ApiKeyRotationGracePeriod time.Duration
+
+	ApiKeyCacheSize        int
+	ApiKeyCacheTTL         time.Duration
+	ApiKeyNegativeCacheTTL time.Duration
+
+	UnknownPropertyPolicy string
+	EventLogBatchMaxSize  int
+	// EventLogStreamMaxSize 為單一 gRPC TrackEvents 串流可接收的事件日誌數量上限
+	EventLogStreamMaxSize int
+	// MaxRequestBodySize 為中介層預先讀取請求內容時的大小上限（bytes），超過時回傳 413
+	MaxRequestBodySize int64
+
+	IdempotencyStore     string
+	IdempotencyWindow    time.Duration
+	IdempotencyCacheSize int
+	// IdempotencyCleanupInterval 為清除 Postgres 中過期冪等鍵的間隔
+	IdempotencyCleanupInterval time.Duration
+
+	UsageFlushInterval  time.Duration
+	QuotaSoftLimitRatio float64
+	QuotaExceededStatus int
+
+	OutboxPollInterval time.Duration
+	OutboxBatchSize    int
+	OutboxMaxAttempts  int
+	// OutboxRetention 為已送達訊息保留的時間，每 OutboxCleanupInterval 刪除超過的訊息
+	OutboxRetention       time.Duration
+	OutboxCleanupInterval time.Duration
+
+	SessionInactivityTimeout time.Duration
+	SessionSweepInterval     time.Duration
+
+	ClickhouseEndpoint string
+	ClickhouseDb       string
+	ClickhouseUser     string
+	ClickhousePassword string
+
+	WorkerBatchSize     int
+	WorkerFlushInterval time.Duration
+}
+
+// QuotaExceededHTTPStatus 超過配額時回傳的狀態碼，僅接受 402 或 429，其餘設定值視為 402
+func (c *Config) QuotaExceededHTTPStatus() int {
+	if c.QuotaExceededStatus == http.StatusTooManyRequests {
+		return http.StatusTooManyRequests
+	}
+	return http.StatusPaymentRequired
+}
+
+// TrustedProxyList 為 TrustedProxies 中的各項，未設定時回傳 nil
+func (c *Config) TrustedProxyList() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(c.TrustedProxies, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
+func (c *Config) PostgresDSN() string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable search_path=%s",
+		c.PostgresHost,
+		c.PostgresUser,
+		c.PostgresPassword,
+		c.PostgresDb,
+		c.PostgresPort,
+		c.PostgresSchema,
+	)
 }
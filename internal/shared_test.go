@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestConfigQuotaExceededHTTPStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   int
+	}{
+		{name: "default", status: 0, want: http.StatusPaymentRequired},
+		{name: "payment required", status: http.StatusPaymentRequired, want: http.StatusPaymentRequired},
+		{name: "too many requests", status: http.StatusTooManyRequests, want: http.StatusTooManyRequests},
+		{name: "unsupported status", status: http.StatusForbidden, want: http.StatusPaymentRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{QuotaExceededStatus: tt.status}
+			if got := c.QuotaExceededHTTPStatus(); got != tt.want {
+				t.Errorf("QuotaExceededHTTPStatus() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
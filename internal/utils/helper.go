@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/md5"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -100,6 +102,12 @@ func Md5(data string) string {
 	return fmt.Sprintf("%x", hash)
 }
 
+// HashAPIKey 以 SHA-256 計算 API 密鑰摘要，資料庫只保存摘要
+func HashAPIKey(apiKey string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(hash[:])
+}
+
 // ChunkArray 將切片分割為指定大小的子切片
 func ChunkArray(data []string, chunkSize int) [][]string {
 	if chunkSize <= 0 {
@@ -136,3 +144,34 @@ func WithRetry(ctx context.Context, maxRetries int, fn func() error) error {
 	}
 	return err
 }
+
+// UserAgentFamilies 依序比對小寫的 User-Agent，Chromium 系瀏覽器的 User-Agent 皆含 Chrome 與 Safari，須先比對
+var UserAgentFamilies = []struct {
+	Family  string
+	Markers []string
+}{
+	{"Bot", []string{"bot", "crawler", "spider"}},
+	{"Edge", []string{"edg/", "edga/", "edgios/"}},
+	{"Opera", []string{"opr/", "opera"}},
+	{"Samsung Internet", []string{"samsungbrowser/"}},
+	{"Chrome", []string{"chrome/", "crios/"}},
+	{"Firefox", []string{"firefox/", "fxios/"}},
+	{"Safari", []string{"safari/"}},
+	{"Internet Explorer", []string{"msie ", "trident/"}},
+}
+
+// UserAgentFamily 取得 User-Agent 的瀏覽器家族，空字串回傳 Unknown，無法辨識時回傳 Other
+func UserAgentFamily(userAgent string) string {
+	if userAgent == "" {
+		return "Unknown"
+	}
+	userAgent = strings.ToLower(userAgent)
+	for _, candidate := range UserAgentFamilies {
+		for _, marker := range candidate.Markers {
+			if strings.Contains(userAgent, marker) {
+				return candidate.Family
+			}
+		}
+	}
+	return "Other"
+}
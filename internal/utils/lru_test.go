@@ -0,0 +1,118 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUGet(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		size   int
+		ttl    time.Duration
+		set    []string
+		at     time.Time
+		key    string
+		wantOK bool
+	}{
+		{name: "hit", size: 2, ttl: time.Minute, set: []string{"a"}, at: now, key: "a", wantOK: true},
+		{name: "miss", size: 2, ttl: time.Minute, set: []string{"a"}, at: now, key: "b", wantOK: false},
+		{name: "within ttl", size: 2, ttl: time.Minute, set: []string{"a"}, at: now.Add(time.Minute), key: "a", wantOK: true},
+		{name: "expired", size: 2, ttl: time.Minute, set: []string{"a"}, at: now.Add(time.Minute + time.Nanosecond), key: "a", wantOK: false},
+		{name: "zero ttl never expires", size: 2, ttl: 0, set: []string{"a"}, at: now.Add(24 * time.Hour), key: "a", wantOK: true},
+		{name: "oldest evicted", size: 2, ttl: time.Minute, set: []string{"a", "b", "c"}, at: now, key: "a", wantOK: false},
+		{name: "newest kept", size: 2, ttl: time.Minute, set: []string{"a", "b", "c"}, at: now, key: "c", wantOK: true},
+		{name: "zero size unbounded", size: 0, ttl: time.Minute, set: []string{"a", "b", "c"}, at: now, key: "a", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewLRU[string, int](tt.size, tt.ttl)
+			for i, key := range tt.set {
+				c.set(key, i, now)
+			}
+			if _, ok := c.get(tt.key, tt.at); ok != tt.wantOK {
+				t.Errorf("get(%q) ok = %v, want %v", tt.key, ok, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLRURecentlyUsedKept(t *testing.T) {
+	now := time.Now()
+	c := NewLRU[string, int](2, time.Minute)
+	c.set("a", 1, now)
+	c.set("b", 2, now)
+	// 讀取 a 後 b 成為最久未使用的項目
+	c.get("a", now)
+	c.set("c", 3, now)
+
+	if _, ok := c.get("a", now); !ok {
+		t.Error("a evicted, want kept")
+	}
+	if _, ok := c.get("b", now); ok {
+		t.Error("b kept, want evicted")
+	}
+}
+
+func TestLRUSetRefreshesExpiry(t *testing.T) {
+	now := time.Now()
+	c := NewLRU[string, int](2, time.Minute)
+	c.set("a", 1, now)
+	c.set("a", 2, now.Add(30*time.Second))
+
+	value, ok := c.get("a", now.Add(80*time.Second))
+	if !ok || value != 2 {
+		t.Errorf("get(a) = %d, %v, want 2, true", value, ok)
+	}
+}
+
+func TestLRUSetIfAbsent(t *testing.T) {
+	tests := []struct {
+		name      string
+		existing  bool
+		ttl       time.Duration
+		wait      time.Duration
+		wantValue int
+		wantSet   bool
+	}{
+		{name: "absent", existing: false, ttl: time.Minute, wantValue: 2, wantSet: true},
+		{name: "present", existing: true, ttl: time.Minute, wantValue: 1, wantSet: false},
+		{name: "expired", existing: true, ttl: time.Millisecond, wait: 5 * time.Millisecond, wantValue: 2, wantSet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewLRU[string, int](2, tt.ttl)
+			if tt.existing {
+				c.Set("a", 1)
+			}
+			time.Sleep(tt.wait)
+
+			value, set := c.SetIfAbsent("a", 2)
+			if value != tt.wantValue || set != tt.wantSet {
+				t.Errorf("SetIfAbsent() = %d, %v, want %d, %v", value, set, tt.wantValue, tt.wantSet)
+			}
+		})
+	}
+}
+
+func TestLRUDeleteAndPurge(t *testing.T) {
+	c := NewLRU[string, int](2, time.Minute)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("a found after Delete")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Error("b missing after deleting a")
+	}
+
+	c.Purge()
+	if _, ok := c.Get("b"); ok {
+		t.Error("b found after Purge")
+	}
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"strings"
+	"time"
+	shared "tracking-service/internal"
+	service "tracking-service/internal/services"
+
+	"github.com/jackc/pgx/v5"
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+const apiKeyListenRetryInterval = 5 * time.Second
+
+// APIKeyCacheInvalidator 以 Postgres LISTEN 接收其他節點的密鑰異動通知並清除本機快取
+type APIKeyCacheInvalidator struct {
+	dsn         string
+	app_service *service.ApplicationService
+}
+
+func NewAPIKeyCacheInvalidator(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	app_service *service.ApplicationService,
+) *APIKeyCacheInvalidator {
+	invalidator := &APIKeyCacheInvalidator{
+		dsn:         config.PostgresDSN(),
+		app_service: app_service,
+	}
+
+	// 未啟用快取時不需監聽
+	if config.ApiKeyCacheSize <= 0 {
+		return invalidator
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go invalidator.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down api key cache invalidator...")
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return invalidator
+}
+
+func (i *APIKeyCacheInvalidator) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	for {
+		err := i.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.WithContext(ctx).WithError(err).Error("Api key invalidation listener disconnected")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(apiKeyListenRetryInterval):
+		}
+	}
+}
+
+func (i *APIKeyCacheInvalidator) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, i.dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{shared.APIKeyInvalidationChannel}.Sanitize()); err != nil {
+		return err
+	}
+
+	// 斷線期間可能漏接通知，重新監聽後清除整個快取
+	i.app_service.PurgeAPIKeyCache()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		i.app_service.EvictAPIKeys(strings.Split(notification.Payload, ","))
+	}
+}
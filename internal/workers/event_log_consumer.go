@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+	util "tracking-service/internal/utils"
+
+	"github.com/IBM/sarama"
+	"github.com/dnwe/otelsarama"
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+// EventLogConsumer 消費 Kafka tracking topic，批次寫入 ClickHouse 後才提交 offset
+type EventLogConsumer struct {
+	group         sarama.ConsumerGroup
+	repo          repository.EventLogRepository
+	batchSize     int
+	flushInterval time.Duration
+	// 近期已寫入的 application_id 與 message_id，用於略過 producer 重送造成的重複訊息
+	seen *util.LRU[string, struct{}]
+}
+
+func NewEventLogConsumer(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	group sarama.ConsumerGroup,
+	repo repository.EventLogRepository,
+) *EventLogConsumer {
+	consumer := &EventLogConsumer{
+		group:         group,
+		repo:          repo,
+		batchSize:     config.WorkerBatchSize,
+		flushInterval: config.WorkerFlushInterval,
+		seen:          util.NewLRU[string, struct{}](config.IdempotencyCacheSize, config.IdempotencyWindow),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go consumer.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down event log consumer...")
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return consumer
+}
+
+func (c *EventLogConsumer) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	handler := otelsarama.WrapConsumerGroupHandler(c)
+	for {
+		// rebalance 後 Consume 會返回，需重新加入 consumer group
+		if err := c.group.Consume(ctx, []string{shared.KafkaTopic}, handler); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+			log.WithError(err).Error("Error from consumer group")
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (c *EventLogConsumer) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c *EventLogConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c *EventLogConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*model.EventLog, 0, c.batchSize)
+	batchMessageIDs := make(map[string]struct{})
+	var last *sarama.ConsumerMessage
+
+	flush := func() error {
+		if last == nil {
+			return nil
+		}
+		if len(batch) > 0 {
+			err := util.WithRetry(ctx, 3, func() error {
+				return c.repo.InsertEventLogs(ctx, batch)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		session.MarkMessage(last, "")
+		session.Commit()
+
+		// 寫入成功後才記錄 message_id，失敗重新消費時不會被誤判為重複
+		for key := range batchMessageIDs {
+			c.seen.Set(key, struct{}{})
+		}
+		clear(batchMessageIDs)
+		batch = batch[:0]
+		last = nil
+		return nil
+	}
+
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return flush()
+			}
+			last = msg
+
+			var eventLog model.EventLog
+			if err := json.Unmarshal(msg.Value, &eventLog); err != nil {
+				// 無法解析的訊息直接略過，避免阻塞整個 partition
+				log.WithContext(ctx).WithError(err).Errorf("Skip malformed event log at partition %d offset %d", msg.Partition, msg.Offset)
+			} else if messageID := messageIDHeader(msg); messageID != "" && c.isDuplicate(dedupKey(eventLog.ApplicationID, messageID), batchMessageIDs) {
+				log.WithContext(ctx).Infof("Skip duplicate event log with message id %s of application %s", messageID, eventLog.ApplicationID)
+			} else {
+				if messageID != "" {
+					batchMessageIDs[dedupKey(eventLog.ApplicationID, messageID)] = struct{}{}
+				}
+				batch = append(batch, &eventLog)
+			}
+
+			if len(batch) >= c.batchSize {
+				if err := flush(); err != nil {
+					log.WithContext(ctx).WithError(err).Error("Failed to insert event logs into clickhouse")
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				log.WithContext(ctx).WithError(err).Error("Failed to insert event logs into clickhouse")
+				return err
+			}
+		case <-ctx.Done():
+			// 尚未寫入的訊息不提交 offset，下次重新消費
+			return nil
+		}
+	}
+}
+
+func (c *EventLogConsumer) isDuplicate(key string, batchMessageIDs map[string]struct{}) bool {
+	if _, ok := batchMessageIDs[key]; ok {
+		return true
+	}
+	_, ok := c.seen.Get(key)
+	return ok
+}
+
+// dedupKey message_id 僅在應用程式內唯一，與 producer 端的冪等鍵相同以應用程式區分
+func dedupKey(applicationID string, messageID string) string {
+	return applicationID + ":" + messageID
+}
+
+func messageIDHeader(msg *sarama.ConsumerMessage) string {
+	for _, header := range msg.Headers {
+		if string(header.Key) == shared.KafkaHeaderMessageID {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"testing"
+	"time"
+	util "tracking-service/internal/utils"
+)
+
+func TestDedupKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     [2]string
+		b     [2]string
+		equal bool
+	}{
+		{name: "same application and message id", a: [2]string{"app-1", "msg-1"}, b: [2]string{"app-1", "msg-1"}, equal: true},
+		{name: "same message id across applications", a: [2]string{"app-1", "msg-1"}, b: [2]string{"app-2", "msg-1"}, equal: false},
+		{name: "different message id", a: [2]string{"app-1", "msg-1"}, b: [2]string{"app-1", "msg-2"}, equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := dedupKey(tt.a[0], tt.a[1])
+			b := dedupKey(tt.b[0], tt.b[1])
+			if (a == b) != tt.equal {
+				t.Errorf("dedupKey(%q) == dedupKey(%q) is %v, want %v", a, b, a == b, tt.equal)
+			}
+		})
+	}
+}
+
+func TestEventLogConsumerIsDuplicate(t *testing.T) {
+	tests := []struct {
+		name  string
+		seen  []string
+		batch []string
+		key   string
+		want  bool
+	}{
+		{name: "new message", key: dedupKey("app-1", "msg-1"), want: false},
+		{name: "written before", seen: []string{dedupKey("app-1", "msg-1")}, key: dedupKey("app-1", "msg-1"), want: true},
+		{name: "earlier in the same batch", batch: []string{dedupKey("app-1", "msg-1")}, key: dedupKey("app-1", "msg-1"), want: true},
+		{name: "same message id of another application", seen: []string{dedupKey("app-2", "msg-1")}, batch: []string{dedupKey("app-2", "msg-1")}, key: dedupKey("app-1", "msg-1"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &EventLogConsumer{seen: util.NewLRU[string, struct{}](10, time.Minute)}
+			for _, key := range tt.seen {
+				c.seen.Set(key, struct{}{})
+			}
+			batch := make(map[string]struct{})
+			for _, key := range tt.batch {
+				batch[key] = struct{}{}
+			}
+
+			if got := c.isDuplicate(tt.key, batch); got != tt.want {
+				t.Errorf("isDuplicate(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
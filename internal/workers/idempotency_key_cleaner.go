@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"context"
+	"time"
+	shared "tracking-service/internal"
+	repository "tracking-service/internal/repositories"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+const idempotencyCleanupBatchSize = 1000
+
+// IdempotencyKeyCleaner 定期刪除 Postgres 中過期的冪等鍵，使用記憶體儲存或 IDEMPOTENCY_CLEANUP_INTERVAL 為 0 時停用
+type IdempotencyKeyCleaner struct {
+	repo            repository.IdempotencyRepository
+	cleanupInterval time.Duration
+}
+
+func NewIdempotencyKeyCleaner(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	repo repository.IdempotencyRepository,
+) *IdempotencyKeyCleaner {
+	cleaner := &IdempotencyKeyCleaner{
+		repo:            repo,
+		cleanupInterval: config.IdempotencyCleanupInterval,
+	}
+	if config.IdempotencyStore != shared.IdempotencyStorePostgres || cleaner.cleanupInterval <= 0 {
+		return cleaner
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go cleaner.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down idempotency key cleaner...")
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return cleaner
+}
+
+func (c *IdempotencyKeyCleaner) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(c.cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.cleanup(ctx)
+		}
+	}
+}
+
+// cleanup 分批刪除，避免單次刪除鎖定過多資料列
+func (c *IdempotencyKeyCleaner) cleanup(ctx context.Context) {
+	var total int64
+	for ctx.Err() == nil {
+		deleted, err := c.repo.DeleteExpiredIdempotencyKeys(ctx, time.Now(), idempotencyCleanupBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithContext(ctx).WithError(err).Error("Failed to delete expired idempotency keys")
+			}
+			break
+		}
+		total += deleted
+		if deleted < idempotencyCleanupBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.WithContext(ctx).Infof("Deleted %d expired idempotency keys", total)
+	}
+}
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	repository "tracking-service/internal/repositories"
+)
+
+type fakeIdempotencyRepository struct {
+	repository.IdempotencyRepository
+	results []int64
+	err     error
+	calls   int
+}
+
+func (r *fakeIdempotencyRepository) DeleteExpiredIdempotencyKeys(_ context.Context, _ time.Time, limit int) (int64, error) {
+	r.calls++
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.calls > len(r.results) {
+		return 0, nil
+	}
+	return min(r.results[r.calls-1], int64(limit)), nil
+}
+
+func TestIdempotencyKeyCleanerCleanup(t *testing.T) {
+	tests := []struct {
+		name      string
+		results   []int64
+		err       error
+		wantCalls int
+	}{
+		{name: "nothing expired", results: []int64{0}, wantCalls: 1},
+		{name: "partial batch stops", results: []int64{10}, wantCalls: 1},
+		{name: "full batches continue", results: []int64{idempotencyCleanupBatchSize, idempotencyCleanupBatchSize, 3}, wantCalls: 3},
+		{name: "error stops", results: []int64{idempotencyCleanupBatchSize}, err: errors.New("connection refused"), wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeIdempotencyRepository{results: tt.results, err: tt.err}
+			c := &IdempotencyKeyCleaner{repo: repo}
+			c.cleanup(context.Background())
+
+			if repo.calls != tt.wantCalls {
+				t.Errorf("DeleteExpiredIdempotencyKeys called %d times, want %d", repo.calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"time"
+	shared "tracking-service/internal"
+	repository "tracking-service/internal/repositories"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+const outboxCleanupBatchSize = 1000
+
+// OutboxCleaner 定期刪除送達超過 OUTBOX_RETENTION 的 outbox 訊息，保留時間或間隔為 0 時停用
+type OutboxCleaner struct {
+	repo            repository.OutboxRepository
+	retention       time.Duration
+	cleanupInterval time.Duration
+}
+
+func NewOutboxCleaner(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	repo repository.OutboxRepository,
+) *OutboxCleaner {
+	cleaner := &OutboxCleaner{
+		repo:            repo,
+		retention:       config.OutboxRetention,
+		cleanupInterval: config.OutboxCleanupInterval,
+	}
+	if cleaner.retention <= 0 || cleaner.cleanupInterval <= 0 {
+		return cleaner
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go cleaner.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down outbox cleaner...")
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return cleaner
+}
+
+func (c *OutboxCleaner) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(c.cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.cleanup(ctx)
+		}
+	}
+}
+
+// cleanup 分批刪除，避免單次刪除鎖定過多資料列
+func (c *OutboxCleaner) cleanup(ctx context.Context) {
+	before := time.Now().Add(-c.retention)
+	var total int64
+	for ctx.Err() == nil {
+		deleted, err := c.repo.DeleteDeliveredOutboxMessages(ctx, before, outboxCleanupBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithContext(ctx).WithError(err).Error("Failed to delete delivered outbox messages")
+			}
+			break
+		}
+		total += deleted
+		if deleted < outboxCleanupBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.WithContext(ctx).Infof("Deleted %d delivered outbox messages", total)
+	}
+}
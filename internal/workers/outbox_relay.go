@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+	shared "tracking-service/internal"
+	model "tracking-service/internal/models"
+	repository "tracking-service/internal/repositories"
+
+	"github.com/IBM/sarama"
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+const (
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+)
+
+// OutboxRelay 定期將 outbox 中待送的訊息重送至 Kafka，失敗時以指數退避排程下次重送
+type OutboxRelay struct {
+	repo         repository.OutboxRepository
+	producer     sarama.SyncProducer
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+}
+
+func NewOutboxRelay(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	repo repository.OutboxRepository,
+	producer sarama.SyncProducer,
+) *OutboxRelay {
+	relay := &OutboxRelay{
+		repo:         repo,
+		producer:     producer,
+		pollInterval: config.OutboxPollInterval,
+		batchSize:    config.OutboxBatchSize,
+		maxAttempts:  config.OutboxMaxAttempts,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go relay.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down outbox relay...")
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return relay
+}
+
+func (r *OutboxRelay) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 單次處理滿批時代表仍有積壓，持續處理直到清空
+			for {
+				n, err := r.relay(ctx)
+				if err != nil {
+					log.WithContext(ctx).WithError(err).Error("Failed to relay outbox messages")
+					break
+				}
+				if n < r.batchSize || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+func (r *OutboxRelay) relay(ctx context.Context) (int, error) {
+	messages, err := r.repo.ClaimDueOutboxMessages(ctx, r.batchSize, repository.OutboxLease)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	msgs := make([]*sarama.ProducerMessage, 0, len(messages))
+	outboxMessages := make(map[*sarama.ProducerMessage]*model.OutboxMessage, len(messages))
+	for _, message := range messages {
+		msg := newProducerMessage(message)
+		msgs = append(msgs, msg)
+		outboxMessages[msg] = message
+	}
+
+	failed := make(map[*model.OutboxMessage]error)
+	if err := r.producer.SendMessages(msgs); err != nil {
+		var producerErrs sarama.ProducerErrors
+		if errors.As(err, &producerErrs) {
+			for _, producerErr := range producerErrs {
+				failed[outboxMessages[producerErr.Msg]] = producerErr.Err
+			}
+		} else {
+			for _, message := range messages {
+				failed[message] = err
+			}
+		}
+	}
+
+	now := time.Now()
+	delivered := make([]string, 0, len(messages))
+	for _, message := range messages {
+		sendErr, ok := failed[message]
+		if !ok {
+			delivered = append(delivered, message.ID)
+			continue
+		}
+
+		lastError := sendErr.Error()
+		message.Attempts++
+		message.LastError = &lastError
+		message.NextAttemptAt = now.Add(outboxBackoff(message.Attempts))
+		message.UpdatedAt = now
+		if r.maxAttempts > 0 && message.Attempts >= r.maxAttempts {
+			message.Status = model.OutboxStatusFailed
+			log.WithContext(ctx).Errorf("Outbox message %s failed after %d attempts: %s", message.ID, message.Attempts, lastError)
+		}
+		if err := r.repo.UpdateOutboxMessage(ctx, message); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(delivered) > 0 {
+		if err := r.repo.MarkOutboxMessagesDelivered(ctx, delivered, now); err != nil {
+			return 0, err
+		}
+		log.WithContext(ctx).Infof("Relayed %d outbox messages to kafka", len(delivered))
+	}
+
+	return len(messages), nil
+}
+
+func newProducerMessage(message *model.OutboxMessage) *sarama.ProducerMessage {
+	msg := &sarama.ProducerMessage{
+		Topic: message.Topic,
+		Value: sarama.ByteEncoder(message.Payload),
+	}
+	if message.MessageKey != "" {
+		msg.Key = sarama.StringEncoder(message.MessageKey)
+	}
+	for _, header := range message.Headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(header.Key), Value: []byte(header.Value)})
+	}
+	return msg
+}
+
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxBaseBackoff
+	for i := 1; i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, outboxMaxBackoff)
+}
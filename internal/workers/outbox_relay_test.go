@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+	model "tracking-service/internal/models"
+
+	"github.com/IBM/sarama"
+)
+
+func TestNewProducerMessageHeaders(t *testing.T) {
+	headers := model.OutboxHeaders{
+		{Key: "tenant_id", Value: "tenant-1"},
+		{Key: "traceparent", Value: "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},
+		{Key: "retry", Value: "1"},
+		{Key: "retry", Value: "2"},
+	}
+
+	// 經資料庫存取後仍保留順序與重複的 key
+	value, err := headers.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var scanned model.OutboxHeaders
+	if err := scanned.Scan([]byte(value.(string))); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	msg := newProducerMessage(&model.OutboxMessage{Topic: "event-logs", Payload: []byte("{}"), Headers: scanned})
+	want := []sarama.RecordHeader{
+		{Key: []byte("tenant_id"), Value: []byte("tenant-1")},
+		{Key: []byte("traceparent"), Value: []byte("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")},
+		{Key: []byte("retry"), Value: []byte("1")},
+		{Key: []byte("retry"), Value: []byte("2")},
+	}
+	if !reflect.DeepEqual(msg.Headers, want) {
+		t.Errorf("Headers = %v, want %v", msg.Headers, want)
+	}
+}
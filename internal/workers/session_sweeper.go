@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"time"
+	shared "tracking-service/internal"
+	service "tracking-service/internal/services"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+// SessionSweeper 定期結束閒置超過逾時的 session，SESSION_SWEEP_INTERVAL 為 0 時停用
+type SessionSweeper struct {
+	app_service   *service.ApplicationService
+	sweepInterval time.Duration
+}
+
+func NewSessionSweeper(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	app_service *service.ApplicationService,
+) *SessionSweeper {
+	sweeper := &SessionSweeper{
+		app_service:   app_service,
+		sweepInterval: config.SessionSweepInterval,
+	}
+	if sweeper.sweepInterval <= 0 {
+		return sweeper
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go sweeper.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down session sweeper...")
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+
+	return sweeper
+}
+
+func (s *SessionSweeper) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ended, err := s.app_service.ExpireIdleSessions(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.WithContext(ctx).WithError(err).Error("Failed to expire idle sessions")
+			}
+			if ended > 0 {
+				log.WithContext(ctx).Infof("Ended %d idle sessions", ended)
+			}
+		}
+	}
+}
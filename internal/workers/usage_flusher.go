@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"time"
+	shared "tracking-service/internal"
+	service "tracking-service/internal/services"
+
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+)
+
+// UsageFlusher 定期將記憶體中彙總的用量累加寫入 Postgres，關閉時寫入剩餘的用量
+type UsageFlusher struct {
+	usage_service *service.UsageService
+	flushInterval time.Duration
+}
+
+func NewUsageFlusher(
+	lc fx.Lifecycle,
+	config *shared.Config,
+	usage_service *service.UsageService,
+) *UsageFlusher {
+	flusher := &UsageFlusher{
+		usage_service: usage_service,
+		flushInterval: config.UsageFlushInterval,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go flusher.run(ctx, done)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			log.Info("Shutting down usage flusher...")
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+			return usage_service.Flush(stopCtx)
+		},
+	})
+
+	return flusher
+}
+
+func (f *UsageFlusher) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(f.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 寫入失敗的用量保留於記憶體，下次一併寫入
+			if err := f.usage_service.Flush(ctx); err != nil {
+				log.WithContext(ctx).WithError(err).Error("Failed to flush usage")
+			}
+		}
+	}
+}
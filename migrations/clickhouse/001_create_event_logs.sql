@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS event_logs
+(
+    id             String,
+    application_id String,
+    session_id     String,
+    event_id       String,
+    platform_id    Int32,
+    properties     String,
+    created_at     DateTime64(3, 'UTC')
+)
+ENGINE = MergeTree
+PARTITION BY toYYYYMM(created_at)
+ORDER BY (application_id, event_id, created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE event_logs
+    ADD COLUMN IF NOT EXISTS client_timestamp Nullable(DateTime64(3, 'UTC')) AFTER properties;
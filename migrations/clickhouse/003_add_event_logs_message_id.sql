@@ -0,0 +1,2 @@
+ALTER TABLE event_logs
+    ADD COLUMN IF NOT EXISTS message_id String DEFAULT '' AFTER id;
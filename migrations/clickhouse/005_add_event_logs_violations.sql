@@ -0,0 +1,2 @@
+ALTER TABLE event_logs
+    ADD COLUMN IF NOT EXISTS violations Array(String) DEFAULT [] AFTER client_timestamp;
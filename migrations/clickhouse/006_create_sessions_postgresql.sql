@@ -0,0 +1,14 @@
+-- 以 PostgreSQL 資料表引擎讀取 Postgres tracking.sessions，供 session 分析與 event_logs 以 session_id 關聯
+-- 連線設定取自 named collection，需先建立，例如：
+-- CREATE NAMED COLLECTION tracking_postgres AS host = 'db', port = 5432, database = 'tracking', user = '...', password = '...';
+CREATE TABLE IF NOT EXISTS sessions
+(
+    id             String,
+    application_id String,
+    platform_id    Int32,
+    user_agent     Nullable(String),
+    started_at     DateTime64(6, 'UTC'),
+    ended_at       Nullable(DateTime64(6, 'UTC')),
+    deleted_at     Nullable(DateTime64(6, 'UTC'))
+)
+ENGINE = PostgreSQL(tracking_postgres, table = 'sessions', schema = 'tracking');
@@ -0,0 +1,2 @@
+ALTER TABLE tracking.event_logs
+    ADD COLUMN IF NOT EXISTS client_timestamp TIMESTAMPTZ;
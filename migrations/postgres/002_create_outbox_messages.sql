@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS tracking.outbox_messages (
+    id              VARCHAR(32) PRIMARY KEY,
+    topic           VARCHAR(255) NOT NULL,
+    message_key     VARCHAR(255),
+    payload         BYTEA NOT NULL,
+    headers         JSONB,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_status_next_attempt_at
+    ON tracking.outbox_messages (status, next_attempt_at);
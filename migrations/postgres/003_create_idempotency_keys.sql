@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS tracking.idempotency_keys (
+    application_id VARCHAR(32) NOT NULL,
+    message_id     VARCHAR(128) NOT NULL,
+    event_log_id   VARCHAR(32) NOT NULL,
+    event_log      BYTEA NOT NULL,
+    expires_at     TIMESTAMPTZ NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (application_id, message_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at
+    ON tracking.idempotency_keys (expires_at);
+
+ALTER TABLE tracking.event_logs
+    ADD COLUMN IF NOT EXISTS message_id VARCHAR(128);
@@ -0,0 +1,20 @@
+-- API 密鑰改存 SHA-256 摘要與前綴，既有密鑰於此轉換後移除明文欄位
+-- 密鑰為 32 bytes 隨機值，不需 salt 即無法以暴力法回推
+ALTER TABLE tracking.applications_api_keys
+    ADD COLUMN IF NOT EXISTS key_hash VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS key_prefix VARCHAR(16);
+
+UPDATE tracking.applications_api_keys
+SET key_hash   = encode(sha256(convert_to(api_key, 'UTF8')), 'hex'),
+    key_prefix = left(api_key, 8)
+WHERE key_hash IS NULL;
+
+ALTER TABLE tracking.applications_api_keys
+    ALTER COLUMN key_hash SET NOT NULL,
+    ALTER COLUMN key_prefix SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_applications_api_keys_key_hash
+    ON tracking.applications_api_keys (key_hash);
+
+ALTER TABLE tracking.applications_api_keys
+    DROP COLUMN IF EXISTS api_key;
@@ -0,0 +1,4 @@
+-- API 密鑰權限範圍，既有密鑰保留全部權限
+ALTER TABLE tracking.applications_api_keys
+    ADD COLUMN IF NOT EXISTS scopes JSONB NOT NULL
+        DEFAULT '["ingest", "schema:read", "schema:write", "sessions"]'::jsonb;
@@ -0,0 +1,5 @@
+-- API 密鑰的到期、最後使用與撤銷時間，皆為 NULL 代表永不過期且未撤銷
+ALTER TABLE tracking.applications_api_keys
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ;
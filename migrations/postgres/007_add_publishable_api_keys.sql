@@ -0,0 +1,6 @@
+-- publishable 密鑰可嵌入瀏覽器，須搭配應用程式的來源允許清單使用
+ALTER TABLE tracking.applications_api_keys
+    ADD COLUMN IF NOT EXISTS type VARCHAR(16) NOT NULL DEFAULT 'secret';
+
+ALTER TABLE tracking.applications
+    ADD COLUMN IF NOT EXISTS allowed_origins JSONB NOT NULL DEFAULT '[]'::jsonb;
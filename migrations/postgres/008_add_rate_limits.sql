@@ -0,0 +1,8 @@
+-- 應用程式與租戶的事件寫入速率限制，per_second 為 0 代表不限制
+ALTER TABLE tracking.applications
+    ADD COLUMN IF NOT EXISTS rate_limit_per_second INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rate_limit_burst INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE tracking.tenants
+    ADD COLUMN IF NOT EXISTS rate_limit_per_second INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rate_limit_burst INTEGER NOT NULL DEFAULT 0;
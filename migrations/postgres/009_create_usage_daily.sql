@@ -0,0 +1,19 @@
+-- 租戶用量計量，各節點於記憶體彙總後定期累加寫入，日期以 UTC 計算
+CREATE TABLE IF NOT EXISTS tracking.usage_daily (
+    application_id VARCHAR(32) NOT NULL,
+    usage_date     DATE NOT NULL,
+    metric         VARCHAR(32) NOT NULL,
+    tenant_id      VARCHAR(32) NOT NULL,
+    accepted       BIGINT NOT NULL DEFAULT 0,
+    rejected       BIGINT NOT NULL DEFAULT 0,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (application_id, usage_date, metric)
+);
+
+CREATE INDEX IF NOT EXISTS idx_usage_daily_tenant_id_usage_date
+    ON tracking.usage_daily (tenant_id, usage_date);
+
+-- 租戶每月用量上限，0 代表不限制
+ALTER TABLE tracking.tenants
+    ADD COLUMN IF NOT EXISTS quota_monthly_event_logs BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS quota_monthly_sessions BIGINT NOT NULL DEFAULT 0;
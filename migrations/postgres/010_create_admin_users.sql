@@ -0,0 +1,19 @@
+-- 後台使用者，密碼以 bcrypt 保存
+CREATE TABLE IF NOT EXISTS tracking.admin_users (
+    id            VARCHAR(32) PRIMARY KEY,
+    email         VARCHAR(255) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    role          VARCHAR(32) NOT NULL,
+    tenant_id     VARCHAR(32),
+    last_login_at TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at    TIMESTAMPTZ
+);
+
+-- 已刪除的使用者不佔用 email
+CREATE UNIQUE INDEX IF NOT EXISTS idx_admin_users_email
+    ON tracking.admin_users (LOWER(email)) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_admin_users_tenant_id
+    ON tracking.admin_users (tenant_id);
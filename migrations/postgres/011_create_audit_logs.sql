@@ -0,0 +1,25 @@
+-- 設定異動稽核紀錄，before 與 after 僅保留異動的欄位
+CREATE TABLE IF NOT EXISTS tracking.audit_logs (
+    id             VARCHAR(32) PRIMARY KEY,
+    tenant_id      VARCHAR(32),
+    application_id VARCHAR(32),
+    actor_type     VARCHAR(32) NOT NULL,
+    actor_id       VARCHAR(64),
+    action         VARCHAR(32) NOT NULL,
+    resource_type  VARCHAR(32) NOT NULL,
+    resource_id    VARCHAR(32) NOT NULL,
+    before         JSONB,
+    after          JSONB,
+    trace_id       VARCHAR(32),
+    client_ip      VARCHAR(64),
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at
+    ON tracking.audit_logs (created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_tenant_id_created_at
+    ON tracking.audit_logs (tenant_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_resource
+    ON tracking.audit_logs (resource_type, resource_id);
@@ -0,0 +1,15 @@
+-- 列表以 (created_at, id) 作為 keyset 分頁，依常用的篩選欄位建立索引
+CREATE INDEX IF NOT EXISTS idx_tenants_created_at_id
+    ON tracking.tenants (created_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_platforms_created_at_id
+    ON tracking.platforms (created_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_applications_tenant_id_created_at_id
+    ON tracking.applications (tenant_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_events_application_id_created_at_id
+    ON tracking.events (application_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_event_fields_event_id_created_at_id
+    ON tracking.event_fields (event_id, created_at, id);
@@ -0,0 +1,3 @@
+-- session 分析依開始時間範圍以 (started_at, id) 分批讀取
+CREATE INDEX IF NOT EXISTS idx_sessions_application_id_started_at_id
+    ON tracking.sessions (application_id, started_at, id);
@@ -0,0 +1,11 @@
+-- 應用程式的 session 閒置逾時秒數，0 代表使用服務預設值
+ALTER TABLE tracking.applications
+    ADD COLUMN IF NOT EXISTS session_timeout_seconds INTEGER NOT NULL DEFAULT 0;
+
+-- 事件寫入時更新，閒置超過逾時的 session 由背景排程結束
+ALTER TABLE tracking.sessions
+    ADD COLUMN IF NOT EXISTS last_activity_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_sessions_open_last_activity
+    ON tracking.sessions ((COALESCE(last_activity_at, started_at)))
+    WHERE ended_at IS NULL AND deleted_at IS NULL;
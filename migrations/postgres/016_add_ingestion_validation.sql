@@ -0,0 +1,6 @@
+-- 事件日誌參照驗證失敗時的處理方式，reject 拒絕寫入，tag 寫入並記錄違反項目
+ALTER TABLE tracking.applications
+    ADD COLUMN IF NOT EXISTS ingestion_validation VARCHAR(16) NOT NULL DEFAULT 'reject';
+
+ALTER TABLE tracking.event_logs
+    ADD COLUMN IF NOT EXISTS violations JSONB;
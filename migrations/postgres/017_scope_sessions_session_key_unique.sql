@@ -0,0 +1,7 @@
+-- session_key 僅在應用程式內唯一，已刪除的 session 不佔用 session_key
+DROP INDEX IF EXISTS tracking.idx_tracking_sessions_session_key;
+DROP INDEX IF EXISTS tracking.idx_sessions_session_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_application_id_session_key
+    ON tracking.sessions (application_id, session_key)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,4 @@
+-- 定期依 delivered_at 刪除超過保留時間的已送達訊息
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_delivered_at
+    ON tracking.outbox_messages (delivered_at)
+    WHERE status = 'delivered';
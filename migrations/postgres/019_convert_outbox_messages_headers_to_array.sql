@@ -0,0 +1,7 @@
+-- headers 由物件改為 [{key, value}] 陣列，保留 Kafka 標頭順序與重複的 key
+UPDATE tracking.outbox_messages
+SET headers = (
+    SELECT COALESCE(jsonb_agg(jsonb_build_object('key', h.key, 'value', h.value)), '[]'::jsonb)
+    FROM jsonb_each_text(headers) AS h
+)
+WHERE jsonb_typeof(headers) = 'object';